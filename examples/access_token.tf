@@ -0,0 +1,4 @@
+resource "gocd_access_token" "automation" {
+  description  = "token used by the release automation"
+  revoke_cause = "rotated by terraform"
+}
@@ -5,4 +5,26 @@ resource "gocd_agent" "sample_agent" {
 
 data "gocd_agent" "sample_agent" {
   uuid = "b9101230-daa8-4e47-bc0f-d010b3d49e04"
-}
\ No newline at end of file
+}
+resource "gocd_agents" "linux_agents" {
+  filter {
+    hostname_regex   = "^sample\\.agent[0-9]+\\.com$"
+    operating_system = "linux"
+  }
+  resources          = ["linux", "docker"]
+  environments       = ["sample_environment"]
+  agent_config_state = "Enabled"
+}
+
+data "gocd_agents" "idle_docker_agents" {
+  hostname_regex     = "^sample\\.agent[0-9]+\\.com$"
+  operating_system   = "linux"
+  resources          = ["docker"]
+  environments       = ["sample_environment"]
+  agent_state        = "Idle"
+  agent_config_state = "Enabled"
+}
+
+output "idle_docker_agents" {
+  value = [for agent in data.gocd_agents.idle_docker_agents.agents : agent.hostname]
+}
@@ -0,0 +1,14 @@
+data "gocd_artifact" "helm_images_version" {
+  pipeline      = "helm-images"
+  latest_passed = true
+  stage         = "build"
+  job           = "package"
+  path          = "dist/version.txt"
+}
+
+output "helm_images_version" {
+  value = {
+    version = trimspace(data.gocd_artifact.helm_images_version.content)
+    sha256  = data.gocd_artifact.helm_images_version.sha256
+  }
+}
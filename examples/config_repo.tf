@@ -47,6 +47,8 @@ resource "gocd_config_repository" "sample_config_repo_yamll" {
       auto_update        = false
     }
   }
+  wait_for_parse = true
+  timeout        = 300
   rules = [
     {
       "directive" : "allow",
@@ -59,4 +61,12 @@ resource "gocd_config_repository" "sample_config_repo_yamll" {
 
 data "gocd_config_repository" "sample_config_repo" {
   profile_id = gocd_config_repository.sample_config_repo.id
-}
\ No newline at end of file
+}
+data "gocd_config_repository_definitions" "sample_config_repo" {
+  profile_id = gocd_config_repository.sample_config_repo.id
+}
+
+resource "gocd_environment" "staging" {
+  name      = "staging"
+  pipelines = data.gocd_config_repository_definitions.sample_config_repo.pipelines
+}
@@ -0,0 +1,19 @@
+data "gocd_config_repo_preflight" "pipelines" {
+  plugin_id  = "yaml.config.plugin"
+  profile_id = "sample_config_repo"
+
+  dynamic "files" {
+    for_each = fileset(path.module, "pipelines/*.gocd.yaml")
+    content {
+      name    = basename(files.value)
+      content = file("${path.module}/${files.value}")
+    }
+  }
+
+  lifecycle {
+    postcondition {
+      condition     = self.valid
+      error_message = join("\n", [for err in self.errors : err.message])
+    }
+  }
+}
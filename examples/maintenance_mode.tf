@@ -0,0 +1,12 @@
+resource "gocd_maintenance_mode" "upgrade" {
+  enabled        = true
+  wait_for_drain = true
+  timeout        = 3600
+
+  timeouts {
+    create = "60m"
+    update = "60m"
+  }
+}
+
+data "gocd_maintenance_mode" "current" {}
@@ -0,0 +1,28 @@
+resource "gocd_package_repository" "yum_repo" {
+  repo_id   = "yum-repo"
+  name      = "yum-repo"
+  plugin_id = "yum"
+  configuration {
+    key   = "REPO_URL"
+    value = "https://yum.example.com/repos/stable"
+  }
+}
+
+resource "gocd_package" "gocd_server" {
+  package_id  = "gocd-server"
+  name        = "gocd-server"
+  repo_id     = gocd_package_repository.yum_repo.repo_id
+  auto_update = false
+  configuration {
+    key   = "PACKAGE_SPEC"
+    value = "go-server-23.*"
+  }
+}
+
+data "gocd_package_repository" "yum_repo" {
+  repo_id = "yum-repo"
+}
+
+data "gocd_package" "gocd_server" {
+  package_id = "gocd-server"
+}
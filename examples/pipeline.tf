@@ -67,4 +67,42 @@ data "gocd_pipeline" "helm_drift" {
   depends_on = [gocd_pipeline.helm_drift]
   name       = "helm-drift"
   yaml       = true
-}
\ No newline at end of file
+}
+resource "gocd_pipeline" "helm_images" {
+  name          = "helm-images"
+  group         = "sample-group"
+  lock_behavior = "none"
+
+  materials {
+    type = "git"
+    attributes {
+      url         = "https://github.com/nikhilsbhat/helm-images.git"
+      branch      = "main"
+      auto_update = true
+    }
+  }
+
+  environment_variables {
+    name  = "HELM_PLUGIN"
+    value = "false"
+  }
+
+  stages {
+    name = "lint"
+    jobs {
+      name = "lint"
+      tasks {
+        type = "exec"
+        attributes {
+          command   = "make"
+          arguments = ["lint"]
+        }
+      }
+    }
+  }
+
+  timer {
+    spec            = "0 0 22 ? * MON-FRI"
+    only_on_changes = true
+  }
+}
@@ -0,0 +1,23 @@
+data "gocd_pipeline_instance" "helm_images" {
+  pipeline      = "helm-images"
+  latest_passed = true
+}
+
+output "helm_images_release" {
+  value = {
+    counter  = data.gocd_pipeline_instance.helm_images.counter
+    label    = data.gocd_pipeline_instance.helm_images.label
+    revision = data.gocd_pipeline_instance.helm_images.material_revisions[0].revision
+  }
+}
+
+data "gocd_pipeline_history" "helm_images" {
+  pipeline  = "helm-images"
+  page_size = 20
+}
+
+data "gocd_pipeline_history" "helm_images_next_page" {
+  pipeline  = "helm-images"
+  page_size = 20
+  after     = data.gocd_pipeline_history.helm_images.next_cursor
+}
@@ -0,0 +1,24 @@
+resource "gocd_pipeline_run" "bootstrap" {
+  pipeline = gocd_pipeline.helm_images.name
+
+  environment_variables = {
+    HELM_PLUGIN = "true"
+  }
+
+  materials {
+    fingerprint = "2bd3b6b4e8d7cda6ab3e8d1e32f6b9e0ac1bd7a6c5f4e3d2c1b0a9f8e7d6c5b4"
+    revision    = "a1b2c3d4"
+  }
+
+  update_materials_before_scheduling = false
+  wait_for_completion                = true
+  timeout                            = 1800
+
+  triggers = {
+    chart_version = "1.2.3"
+  }
+}
+
+output "bootstrap_result" {
+  value = gocd_pipeline_run.bootstrap.result
+}
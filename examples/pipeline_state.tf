@@ -0,0 +1,6 @@
+resource "gocd_pipeline_state" "helm_images" {
+  pipeline     = gocd_pipeline.helm_images.name
+  paused       = true
+  pause_reason = "paused until the migration completes"
+  unlock       = true
+}
@@ -0,0 +1,10 @@
+data "gocd_pipelines" "helm_pipelines" {
+  group      = "helm-charts"
+  name_regex = "^helm-"
+  origin     = "config_repo"
+}
+
+resource "gocd_environment" "helm" {
+  name      = "helm"
+  pipelines = [for pipeline in data.gocd_pipelines.helm_pipelines.pipelines : pipeline.name]
+}
@@ -1,3 +1,21 @@
 data "gocd_plugin_info" "kubernetes_plugin" {
   plugin_id = "cd.go.contrib.secrets.kubernetes"
-}
\ No newline at end of file
+}
+
+data "gocd_plugins" "elastic_agents" {
+  extension_type = "elastic-agent"
+  status         = "active"
+  bundled_plugin = false
+}
+
+resource "gocd_cluster_profile" "kubernetes" {
+  profile_id = "kubernetes"
+  plugin_id  = one(data.gocd_plugins.elastic_agents.plugin_ids)
+
+  lifecycle {
+    precondition {
+      condition     = length(data.gocd_plugins.elastic_agents.plugin_ids) == 1
+      error_message = "exactly one active elastic agent plugin is expected to be installed in GoCD"
+    }
+  }
+}
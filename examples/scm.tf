@@ -0,0 +1,36 @@
+resource "gocd_scm" "github_pr" {
+  name      = "gocd-github-pr"
+  plugin_id = "github.pr"
+  configuration {
+    key   = "url"
+    value = "https://github.com/gocd/gocd.git"
+  }
+}
+
+resource "gocd_pipeline" "pull_requests" {
+  name  = "gocd-pull-requests"
+  group = "pull-requests"
+  materials {
+    type = "plugin"
+    attributes {
+      ref = gocd_scm.github_pr.scm_id
+    }
+  }
+  stages {
+    name = "build"
+    jobs {
+      name = "build"
+      tasks {
+        type = "exec"
+        attributes {
+          command   = "make"
+          arguments = ["build"]
+        }
+      }
+    }
+  }
+}
+
+data "gocd_scm" "github_pr" {
+  name = "gocd-github-pr"
+}
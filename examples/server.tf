@@ -0,0 +1,5 @@
+data "gocd_server" "current" {}
+
+output "gocd_server_errors" {
+  value = [for message in data.gocd_server.current.health_messages : message.message if message.level == "ERROR"]
+}
@@ -0,0 +1,28 @@
+resource "gocd_site_urls" "site_urls" {
+  site_url        = "http://gocd.example.com"
+  secure_site_url = "https://gocd.example.com"
+}
+
+resource "gocd_encrypt_value" "mail_password" {
+  value = "mail-server-password"
+}
+
+resource "gocd_mail_server" "mail_server" {
+  hostname           = "smtp.example.com"
+  port               = 587
+  username           = "gocd"
+  encrypted_password = gocd_encrypt_value.mail_password.encrypted_value
+  tls                = true
+  sender_email       = "no-reply@example.com"
+  admin_email        = "gocd-admins@example.com"
+}
+
+resource "gocd_artifacts_config" "artifacts" {
+  artifacts_dir          = "/var/lib/go-server/artifacts"
+  purge_start_disk_space = 10
+  purge_upto_disk_space  = 20
+}
+
+resource "gocd_default_job_timeout" "timeout" {
+  timeout = 60
+}
@@ -0,0 +1,7 @@
+resource "gocd_system_admin_member" "jdoe" {
+  user = "jdoe"
+}
+
+resource "gocd_system_admin_member" "platform_team" {
+  role = "platform-team"
+}
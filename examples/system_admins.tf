@@ -0,0 +1,4 @@
+resource "gocd_system_admins" "admins" {
+  users = ["admin", "jdoe"]
+  roles = ["gocd-admins"]
+}
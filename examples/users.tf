@@ -0,0 +1,10 @@
+resource "gocd_user" "sample_user" {
+  login_name      = "jdoe"
+  email           = "jdoe@example.com"
+  email_me        = true
+  checkin_aliases = ["jdoe", "john.doe@example.com"]
+}
+
+data "gocd_users" "admins" {
+  is_admin = true
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/common/content"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// gocdConfigDefaults holds the values GoCD falls back to when the respective fields are not set in the config,
+// the fields set with these values are treated same as the fields that were not set.
+// The defaults are scoped to the object they belong to, see configScope. Defaults of the task and material attributes
+// are scoped by their type, and the ones scoped with `*` apply to the attributes of all the types.
+var gocdConfigDefaults = map[string]map[string]any{
+	"pipeline": {
+		"lock_behavior":  "none",
+		"label_template": "${COUNT}",
+	},
+	"stage": {
+		"fetch_materials":         true,
+		"clean_working_directory": false,
+		"never_cleanup_artifacts": false,
+	},
+	"approval": {
+		"type":                  "success",
+		"allow_only_on_success": false,
+	},
+	"environment_variable": {
+		"secure": false,
+	},
+	"timer": {
+		"only_on_changes": false,
+	},
+	"task.*": {
+		"run_if": []any{"passed"},
+	},
+	"task.fetch": {
+		"artifact_origin":  "gocd",
+		"is_source_a_file": false,
+	},
+	"material.*": {
+		"auto_update":   true,
+		"invert_filter": false,
+	},
+	"material.git": {
+		"branch":        "master",
+		"shallow_clone": false,
+	},
+	"material.svn": {
+		"check_externals": false,
+	},
+	"material.p4": {
+		"use_tickets": false,
+	},
+	"material.dependency": {
+		"ignore_for_scheduling": false,
+	},
+}
+
+// configScopes maps the keys of the config to the scope of the objects they hold.
+var configScopes = map[string]string{
+	"stages":                "stage",
+	"jobs":                  "job",
+	"tasks":                 "task",
+	"on_cancel":             "task",
+	"materials":             "material",
+	"environment_variables": "environment_variable",
+	"approval":              "approval",
+	"timer":                 "timer",
+}
+
+// decodeConfig decodes the json/yaml config passed to pipeline or template, it also reports whether the config was of type yaml.
+func decodeConfig(config string) (map[string]any, bool, error) {
+	var configMap map[string]any
+
+	obj := content.Object(config)
+
+	switch objType := obj.CheckFileType(logrus.New()); objType {
+	case content.FileTypeJSON:
+		if err := json.Unmarshal([]byte(obj.String()), &configMap); err != nil {
+			return nil, false, err
+		}
+
+		return configMap, false, nil
+	case content.FileTypeYAML:
+		if err := yaml.Unmarshal([]byte(obj.String()), &configMap); err != nil {
+			return nil, true, err
+		}
+
+		return configMap, true, nil
+	default:
+		return nil, false, errors.New("config type is unknown")
+	}
+}
+
+// suppressEquivalentConfigDiff suppresses the diff on `config` when both the old and new configs are semantically same,
+// it ignores the ordering of keys, indentation, the format (yaml/json) and the fields set to GoCD defaults.
+func suppressEquivalentConfigDiff(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	if oldValue == newValue {
+		return true
+	}
+
+	oldConfig, _, err := decodeConfig(oldValue)
+	if err != nil {
+		return false
+	}
+
+	newConfig, _, err := decodeConfig(newValue)
+	if err != nil {
+		return false
+	}
+
+	equivalent, err := configsEquivalent(stripServerFields(oldConfig), stripServerFields(newConfig))
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+// configsEquivalent compares canonical forms of the passed configs.
+func configsEquivalent(oldConfig, newConfig any) (bool, error) {
+	oldCanonical, err := canonicalConfig(oldConfig)
+	if err != nil {
+		return false, err
+	}
+
+	newCanonical, err := canonicalConfig(newConfig)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(oldCanonical, newCanonical), nil
+}
+
+// canonicalConfig round trips the config through json so that values decoded from yaml and json are of same type,
+// and drops the empty values and the values that are same as GoCD defaults.
+func canonicalConfig(config any) (any, error) {
+	out, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded any
+	if err = json.Unmarshal(out, &decoded); err != nil {
+		return nil, err
+	}
+
+	// the root of the config is of a pipeline or a template, templates do not have any of the pipeline defaults.
+	canonical, _ := canonicalValue("pipeline", decoded)
+
+	return canonical, nil
+}
+
+// canonicalValue returns the canonical form of the value of the passed scope, and whether it is to be kept.
+func canonicalValue(scope string, value any) (any, bool) {
+	switch typedValue := value.(type) {
+	case nil:
+		return nil, false
+	case map[string]any:
+		canonical := make(map[string]any, len(typedValue))
+
+		for childKey, childValue := range typedValue {
+			canonicalChild, keep := canonicalValue(configScope(scope, childKey, typedValue), childValue)
+			if !keep || isConfigDefault(scope, childKey, canonicalChild) {
+				continue
+			}
+
+			canonical[childKey] = canonicalChild
+		}
+
+		if len(canonical) == 0 {
+			return nil, false
+		}
+
+		return canonical, true
+	case []any:
+		if len(typedValue) == 0 {
+			return nil, false
+		}
+
+		canonical := make([]any, 0, len(typedValue))
+
+		for _, element := range typedValue {
+			canonicalElement, keep := canonicalValue(scope, element)
+			if !keep {
+				canonicalElement = nil
+			}
+
+			canonical = append(canonical, canonicalElement)
+		}
+
+		return canonical, true
+	default:
+		return typedValue, true
+	}
+}
+
+// configScope returns the scope of the value held by the key of an object of the passed scope,
+// the attributes of tasks and materials are scoped by their type ex: material.git.
+func configScope(scope, key string, object map[string]any) string {
+	if key == "attributes" && (scope == "task" || scope == "material") {
+		objectType, _ := object["type"].(string)
+
+		return scope + "." + objectType
+	}
+
+	return configScopes[key]
+}
+
+// isConfigDefault reports whether the canonical value of the key of an object of the passed scope is same as the GoCD default.
+func isConfigDefault(scope, key string, value any) bool {
+	scopes := []string{scope}
+	if parent, _, found := strings.Cut(scope, "."); found {
+		scopes = append(scopes, parent+".*")
+	}
+
+	for _, defaultScope := range scopes {
+		if defaultValue, ok := gocdConfigDefaults[defaultScope][key]; ok && reflect.DeepEqual(value, defaultValue) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stripServerFields drops the links that GoCD adds to the config it returns.
+func stripServerFields(value any) any {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		stripped := make(map[string]any, len(typedValue))
+
+		for key, val := range typedValue {
+			if key == "_links" {
+				continue
+			}
+
+			stripped[key] = stripServerFields(val)
+		}
+
+		return stripped
+	case []any:
+		stripped := make([]any, 0, len(typedValue))
+		for _, val := range typedValue {
+			stripped = append(stripped, stripServerFields(val))
+		}
+
+		return stripped
+	default:
+		return value
+	}
+}
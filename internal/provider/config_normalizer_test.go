@@ -0,0 +1,248 @@
+//nolint:testpackage
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSuppressEquivalentConfigDiff(t *testing.T) {
+	jsonConfig := `{
+		"name": "sample-template",
+		"stages": [{
+			"name": "build",
+			"fetch_materials": true,
+			"jobs": [{
+				"name": "build",
+				"tasks": [{
+					"type": "exec",
+					"attributes": {"command": "echo", "arguments": ["building"], "run_if": []}
+				}]
+			}]
+		}]
+	}`
+
+	tests := map[string]struct {
+		newConfig string
+		suppress  bool
+	}{
+		"reordered and compact json": {
+			newConfig: `{"stages":[{"jobs":[{"tasks":[{"attributes":{"run_if":[],"arguments":["building"],"command":"echo"},"type":"exec"}],` +
+				`"name":"build"}],"name":"build"}],"name":"sample-template"}`,
+			suppress: true,
+		},
+		"equivalent yaml with gocd defaults": {
+			newConfig: `
+name: sample-template
+stages:
+  - name: build
+    approval:
+      type: success
+      allow_only_on_success: false
+    jobs:
+      - name: build
+        timeout: null
+        resources: []
+        tasks:
+          - type: exec
+            attributes:
+              command: echo
+              arguments:
+                - building
+              run_if:
+                - passed
+`,
+			suppress: true,
+		},
+		"changed command": {
+			newConfig: `
+name: sample-template
+stages:
+  - name: build
+    jobs:
+      - name: build
+        tasks:
+          - type: exec
+            attributes:
+              command: make
+              arguments:
+                - building
+`,
+			suppress: false,
+		},
+		"changed run_if": {
+			newConfig: `
+name: sample-template
+stages:
+  - name: build
+    jobs:
+      - name: build
+        tasks:
+          - type: exec
+            attributes:
+              command: echo
+              arguments:
+                - building
+              run_if:
+                - failed
+`,
+			suppress: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := suppressEquivalentConfigDiff("config", jsonConfig, tt.newConfig, nil); got != tt.suppress {
+				t.Fatalf("expected diff suppression to be %t, got %t", tt.suppress, got)
+			}
+		})
+	}
+}
+
+func TestPipelineConfigChangedWithGoCDResponse(t *testing.T) {
+	// pipeline config as returned by GoCD for the minimal config, with the server filled defaults.
+	response := `{
+  "_links": {
+    "self": {"href": "https://ci.example.com/go/api/admin/pipelines/helm-drift"},
+    "doc": {"href": "https://api.gocd.org/#pipeline-config"},
+    "find": {"href": "https://ci.example.com/go/api/admin/pipelines/:pipeline_name"}
+  },
+  "label_template": "${COUNT}",
+  "lock_behavior": "none",
+  "name": "helm-drift",
+  "template": null,
+  "group": "sample-group",
+  "origin": {
+    "_links": {"self": {"href": "https://ci.example.com/go/admin/config_xml"}},
+    "type": "gocd"
+  },
+  "parameters": [],
+  "environment_variables": [{"secure": false, "name": "GO_ENV", "value": "ci"}],
+  "materials": [
+    {
+      "type": "git",
+      "attributes": {
+        "url": "https://github.com/nikhilsbhat/helm-drift.git",
+        "destination": null,
+        "filter": null,
+        "invert_filter": false,
+        "name": null,
+        "auto_update": true,
+        "branch": "master",
+        "submodule_folder": null,
+        "shallow_clone": false
+      }
+    }
+  ],
+  "stages": [
+    {
+      "name": "build",
+      "fetch_materials": true,
+      "clean_working_directory": false,
+      "never_cleanup_artifacts": false,
+      "approval": {
+        "type": "success",
+        "allow_only_on_success": false,
+        "authorization": {"roles": [], "users": []}
+      },
+      "environment_variables": [],
+      "jobs": [
+        {
+          "name": "build",
+          "run_instance_count": null,
+          "timeout": null,
+          "environment_variables": [],
+          "resources": [],
+          "tasks": [
+            {
+              "type": "exec",
+              "attributes": {"run_if": ["passed"], "command": "make", "arguments": ["build"], "working_directory": null}
+            }
+          ],
+          "tabs": [],
+          "artifacts": []
+        }
+      ]
+    }
+  ],
+  "tracking_tool": null,
+  "timer": null
+}`
+
+	var liveConfig map[string]any
+	if err := json.Unmarshal([]byte(response), &liveConfig); err != nil {
+		t.Fatalf("decoding pipeline config response errored with: %v", err)
+	}
+
+	liveConfig = normalizePipelineConfig(liveConfig)
+
+	minimalConfig := `
+name: helm-drift
+environment_variables:
+  - name: GO_ENV
+    value: ci
+materials:
+  - type: git
+    attributes:
+      url: https://github.com/nikhilsbhat/helm-drift.git
+stages:
+  - name: build
+    jobs:
+      - name: build
+        tasks:
+          - type: exec
+            attributes:
+              command: make
+              arguments:
+                - build
+`
+
+	changed, err := pipelineConfigChanged(minimalConfig, liveConfig)
+	if err != nil {
+		t.Fatalf("comparing pipeline config errored with: %v", err)
+	}
+
+	if changed {
+		t.Fatal("minimal pipeline config should be treated same as the config filled with defaults by GoCD")
+	}
+
+	changed, err = pipelineConfigChanged(strings.Replace(minimalConfig, "url: https", "branch: main\n      url: https", 1), liveConfig)
+	if err != nil {
+		t.Fatalf("comparing pipeline config errored with: %v", err)
+	}
+
+	if !changed {
+		t.Fatal("branch other than the GoCD default should be detected as a change")
+	}
+}
+
+func TestConfigDefaultsAreScoped(t *testing.T) {
+	liveConfig := `{"name": "helm-drift", "materials": [{"type": "hg", "attributes": {"url": "https://hg.example.com/helm-drift"}}]}`
+
+	tests := map[string]struct {
+		newConfig string
+		suppress  bool
+	}{
+		"hg material with the default branch of git material": {
+			newConfig: `{"name": "helm-drift", "materials": [{"type": "hg", "attributes": {"url": "https://hg.example.com/helm-drift", "branch": "master"}}]}`,
+			suppress:  false,
+		},
+		"hg material with the defaults common to all the materials": {
+			newConfig: `{"name": "helm-drift", "materials": [{"type": "hg", "attributes": {"url": "https://hg.example.com/helm-drift", "auto_update": true}}]}`,
+			suppress:  true,
+		},
+		"stage default set on the pipeline": {
+			newConfig: `{"name": "helm-drift", "fetch_materials": true, "materials": [{"type": "hg", "attributes": {"url": "https://hg.example.com/helm-drift"}}]}`,
+			suppress:  false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := suppressEquivalentConfigDiff("config", liveConfig, tt.newConfig, nil); got != tt.suppress {
+				t.Fatalf("expected diff suppression to be %t, got %t", tt.suppress, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+	"github.com/spf13/cast"
+)
+
+// agentFilters are the attributes of gocd_agents used to filter the agents.
+var agentFilters = []string{
+	utils.TerraformResourceHostnameRegex,
+	utils.TerraformResourceOperatingSystem,
+	utils.TerraformResourceResources,
+	utils.TerraformResourceEnvironments,
+	utils.TerraformResourceAgentState,
+	utils.TerraformResourceAgentConfigState,
+	utils.TerraformResourceElasticPluginAD,
+}
+
+func dataSourceAgents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceAgentsRead,
+		Schema: map[string]*schema.Schema{
+			"hostname_regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "Regular expression to filter the agents by their hostname.",
+			},
+			"operating_system": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the agents by the operating system reported by them (case-insensitive).",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the agents that are tagged with all of the resources.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"environments": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the agents that belong to all of the environments.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"agent_state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the agents by their state (case-insensitive). Can be one of `Idle`, `Building`, `LostContact`, `Missing`, `Unknown`.",
+			},
+			"agent_config_state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the agents by whether they are enabled or not (case-insensitive). Can be one of `Pending`, `Enabled`, `Disabled`.",
+			},
+			"elastic_plugin_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the elastic agents managed by the elastic agent plugin.",
+			},
+			"agents": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of agents matching the filters.",
+				Elem:        &schema.Resource{Schema: agentSchema()},
+			},
+		},
+	}
+}
+
+// agentSchema returns the attributes of an agent shared by the gocd_agent and gocd_agents data sources.
+func agentSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"uuid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The identifier of the agent.",
+		},
+		"hostname": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The hostname of the agent.",
+		},
+		"elastic_agent_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The elastic agent identifier of the agent. This attribute is only available if the agent is an elastic agent.",
+		},
+		"elastic_plugin_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The identifier of the elastic agent plugin that manages the agent instance. This attribute is only available if the agent is an elastic agent.",
+		},
+		"ip_address": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The IP address of the agent.",
+		},
+		"sandbox": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The path where the agent will perform its builds.",
+		},
+		"operating_system": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The operating system as reported by the agent.",
+		},
+		"free_space": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The amount of free space in bytes.",
+		},
+		"agent_config_state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Whether the agent is enabled or not. Can be one of `Pending`, `Enabled`, `Disabled`.",
+		},
+		"agent_state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The state the agent is in. Can be one of `Idle`, `Building`, `LostContact`, `Missing`, `Unknown`.",
+		},
+		"agent_version": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The version of the agent.",
+		},
+		"resources": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The set of resources that the agent is tagged with.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"environments": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The set of environments that the agent belongs to.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"build_state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "If the agent is running a build, the state of the build on the agent. Can be one of `Idle`, `Building`, `Cancelled`, `Unknown`.",
+		},
+		"build_details": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "The build details provides information like pipeline, stage and job if the build_state of the agent is `Building`.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func datasourceAgentsRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		newID, err := utils.GetRandomID()
+		if err != nil {
+			return diag.Errorf("errored while fetching randomID %v", err)
+		}
+
+		id = newID
+	}
+
+	response, err := defaultConfig.GetAgents()
+	if err != nil {
+		return diag.Errorf("getting agents errored with: %v", err)
+	}
+
+	filter := make(map[string]any, len(agentFilters))
+	for _, key := range agentFilters {
+		filter[key] = d.Get(key)
+	}
+
+	filteredAgents, err := filterAgents(response, filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	agents := make([]map[string]any, 0, len(filteredAgents))
+
+	for _, agent := range filteredAgents {
+		flattenedAgent, err := flattenAgent(agent)
+		if err != nil {
+			return diag.Errorf("flattening agent '%s' errored with: %v", agent.ID, err)
+		}
+
+		agents = append(agents, flattenedAgent)
+	}
+
+	if err = d.Set(utils.TerraformResourceAgents, agents); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceAgents, err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+// filterAgents returns the agents matching all the filters set, filters that are not set match every agent.
+func filterAgents(agents []gocd.Agent, filter map[string]any) ([]gocd.Agent, error) {
+	var hostnameRegex *regexp.Regexp
+
+	if pattern := utils.String(filter[utils.TerraformResourceHostnameRegex]); len(pattern) != 0 {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("compiling %s '%s' errored with: %w", utils.TerraformResourceHostnameRegex, pattern, err)
+		}
+
+		hostnameRegex = regex
+	}
+
+	operatingSystem := utils.String(filter[utils.TerraformResourceOperatingSystem])
+	agentState := utils.String(filter[utils.TerraformResourceAgentState])
+	elasticPluginID := utils.String(filter[utils.TerraformResourceElasticPluginAD])
+	configState := utils.String(filter[utils.TerraformResourceAgentConfigState])
+	resources, _ := filter[utils.TerraformResourceResources].([]any)
+	environments, _ := filter[utils.TerraformResourceEnvironments].([]any)
+
+	filteredAgents := make([]gocd.Agent, 0)
+
+	for _, agent := range agents {
+		if hostnameRegex != nil && !hostnameRegex.MatchString(agent.Name) {
+			continue
+		}
+
+		if len(operatingSystem) != 0 && !strings.EqualFold(agent.OS, operatingSystem) {
+			continue
+		}
+
+		if len(agentState) != 0 && !strings.EqualFold(agent.CurrentState, agentState) {
+			continue
+		}
+
+		if len(elasticPluginID) != 0 && agent.ElasticPluginID != elasticPluginID {
+			continue
+		}
+
+		if !agentConfigured(agent, utils.GetSlice(resources), utils.GetSlice(environments), configState) {
+			continue
+		}
+
+		filteredAgents = append(filteredAgents, agent)
+	}
+
+	return filteredAgents, nil
+}
+
+func flattenAgent(agent gocd.Agent) (map[string]any, error) {
+	buildDetails, err := utils.Map(agent.BuildDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	environments := make([]string, 0)
+	if agent.Environments != nil {
+		environments = flattenEnvironments(agent.Environments)
+	}
+
+	return map[string]any{
+		utils.TerraformResourceUUID:             agent.ID,
+		utils.TerraformResourceHostname:         agent.Name,
+		utils.TerraformResourceElasticAgentAD:   agent.ElasticAgentID,
+		utils.TerraformResourceElasticPluginAD:  agent.ElasticPluginID,
+		utils.TerraformResourceIPAddress:        agent.IPAddress,
+		utils.TerraformResourceSandbox:          agent.Sandbox,
+		utils.TerraformResourceOperatingSystem:  agent.OS,
+		utils.TerraformResourceFreeSpace:        cast.ToFloat64(agent.DiskSpaceAvailable),
+		utils.TerraformResourceAgentConfigState: agent.ConfigState,
+		utils.TerraformResourceAgentState:       agent.CurrentState,
+		utils.TerraformResourceAgentVersion:     agent.Version,
+		utils.TerraformResourceResources:        agent.Resources,
+		utils.TerraformResourceEnvironments:     environments,
+		utils.TerraformResourceBuildState:       agent.BuildState,
+		utils.TerraformResourceBuildDetails:     buildDetails,
+	}, nil
+}
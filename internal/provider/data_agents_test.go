@@ -0,0 +1,95 @@
+//nolint:testpackage
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+func TestFilterAgents(t *testing.T) {
+	agents := []gocd.Agent{
+		{
+			ID: "agent-1", Name: "sample.agent001.com", OS: "Linux", CurrentState: "Idle", ConfigState: "Enabled",
+			Resources: []string{"docker", "dev"}, Environments: []any{map[string]any{"name": "production"}},
+		},
+		{ID: "agent-2", Name: "sample.agent002.com", OS: "Mac OS X", CurrentState: "Building", ConfigState: "Enabled", Resources: []string{"docker"}},
+		{ID: "agent-3", Name: "build.agent003.com", OS: "Linux", CurrentState: "LostContact", ConfigState: "Disabled", ElasticPluginID: "cd.go.contrib.elastic-agent.docker"},
+	}
+
+	tests := map[string]struct {
+		filter   map[string]any
+		expected []string
+		wantErr  bool
+	}{
+		"no filters": {
+			filter:   agentsFilter(map[string]any{}),
+			expected: []string{"agent-1", "agent-2", "agent-3"},
+		},
+		"filter by hostname and operating system": {
+			filter:   agentsFilter(map[string]any{"hostname_regex": "^sample", "operating_system": "linux"}),
+			expected: []string{"agent-1"},
+		},
+		"filter by agent and config state": {
+			filter:   agentsFilter(map[string]any{"agent_state": "lostcontact", "agent_config_state": "disabled"}),
+			expected: []string{"agent-3"},
+		},
+		"filter by all resources": {
+			filter:   agentsFilter(map[string]any{"resources": []any{"docker", "dev"}}),
+			expected: []string{"agent-1"},
+		},
+		"filter by environments": {
+			filter:   agentsFilter(map[string]any{"environments": []any{"production"}}),
+			expected: []string{"agent-1"},
+		},
+		"filter by elastic plugin": {
+			filter:   agentsFilter(map[string]any{"elastic_plugin_id": "cd.go.contrib.elastic-agent.docker"}),
+			expected: []string{"agent-3"},
+		},
+		"no agent matches": {
+			filter:   agentsFilter(map[string]any{"hostname_regex": "^sample", "agent_config_state": "disabled"}),
+			expected: []string{},
+		},
+		"invalid hostname regex": {
+			filter:  agentsFilter(map[string]any{"hostname_regex": "["}),
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			filtered, err := filterAgents(agents, tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error to be %t, got: %v", tt.wantErr, err)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got := getAgentIDs(filtered); !reflect.DeepEqual(got, tt.expected) {
+				t.Fatalf("expected agents %v to match the filters, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// agentsFilter sets the filters not overridden to their zero value, the way they are read from the data source.
+func agentsFilter(overrides map[string]any) map[string]any {
+	filter := map[string]any{
+		"hostname_regex":     "",
+		"operating_system":   "",
+		"resources":          []any{},
+		"environments":       []any{},
+		"agent_state":        "",
+		"agent_config_state": "",
+		"elastic_plugin_id":  "",
+	}
+
+	for key, value := range overrides {
+		filter[key] = value
+	}
+
+	return filter
+}
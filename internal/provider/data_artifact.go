@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"log"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const (
+	defaultArtifactMaxSize = 1048576
+	maxArtifactMaxSize     = 10485760
+)
+
+func dataSourceArtifact() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceArtifactRead,
+		Schema: map[string]*schema.Schema{
+			"pipeline": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the pipeline that published the artifact.",
+			},
+			"pipeline_counter": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{utils.TerraformResourcePipelineCounter, utils.TerraformResourceLatestPassed},
+				Description:  "The counter of the pipeline instance that published the artifact.",
+			},
+			"latest_passed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Description: "Enable to fetch the artifact from the latest instance of the pipeline in which all the stages passed.",
+			},
+			"stage": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the stage that published the artifact.",
+			},
+			"stage_counter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The counter of the stage instance that published the artifact, defaults to the latest run of the stage in the pipeline instance.",
+			},
+			"job": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the job that published the artifact.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The path of the artifact file, relative to the artifacts of the job ex: dist/version.txt.",
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     false,
+				Default:      defaultArtifactMaxSize,
+				ValidateFunc: validation.IntBetween(1, maxArtifactMaxSize),
+				Description:  "The maximum size of the artifact file in bytes, larger files are not downloaded as the content is stored in the state. Can be at most 10485760 (10 MiB).",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content of the artifact file, set only when the file is a valid UTF-8 text.",
+			},
+			"content_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded content of the artifact file, set only when the file is not a valid UTF-8 text.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the artifact file in bytes.",
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hex encoded SHA256 checksum of the artifact file.",
+			},
+		},
+	}
+}
+
+func datasourceArtifactRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	runClient := meta.(gocdclient.PipelineRunClient)
+	artifactClient := meta.(gocdclient.ArtifactClient)
+
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+
+	var (
+		instance gocdclient.PipelineInstance
+		err      error
+	)
+
+	if utils.Bool(d.Get(utils.TerraformResourceLatestPassed)) {
+		instance, err = getLatestPipelineInstance(runClient, pipeline, true)
+	} else {
+		instance, err = runClient.GetPipelineInstanceRaw(pipeline, d.Get(utils.TerraformResourcePipelineCounter).(int))
+	}
+
+	if err != nil {
+		return diag.Errorf("getting instance of pipeline '%s' errored with: %v", pipeline, err)
+	}
+
+	locator := gocdclient.ArtifactLocator{
+		Pipeline:        pipeline,
+		PipelineCounter: instance.Counter,
+		Stage:           utils.String(d.Get(utils.TerraformResourceStage)),
+		StageCounter:    utils.String(d.Get(utils.TerraformResourceStageCounter)),
+		Job:             utils.String(d.Get(utils.TerraformResourceJob)),
+		Path:            utils.String(d.Get(utils.TerraformResourcePath)),
+	}
+
+	if len(locator.StageCounter) == 0 {
+		for _, stage := range instance.Stages {
+			if stage.Name == locator.Stage {
+				locator.StageCounter = stage.Counter.String()
+			}
+		}
+
+		if len(locator.StageCounter) == 0 {
+			return diag.Errorf("stage '%s' not found in the instance '%d' of pipeline '%s'", locator.Stage, instance.Counter, pipeline)
+		}
+	}
+
+	content, err := artifactClient.GetArtifactRaw(locator, int64(d.Get(utils.TerraformResourceMaxSize).(int)))
+	if err != nil {
+		return diag.Errorf("getting artifact errored with: %v", err)
+	}
+
+	// only one of the content is set, so that the state holds the artifact just once.
+	var textContent, base64Content string
+	if utf8.Valid(content) {
+		textContent = string(content)
+	} else {
+		log.Printf("artifact '%s' is not a valid UTF-8 text, hence only content_base64 is set", locator)
+
+		base64Content = base64.StdEncoding.EncodeToString(content)
+	}
+
+	checksum := sha256.Sum256(content)
+
+	attributes := map[string]any{
+		utils.TerraformResourcePipelineCounter: instance.Counter,
+		utils.TerraformResourceStageCounter:    locator.StageCounter,
+		utils.TerraformResourceContent:         textContent,
+		utils.TerraformResourceContentBase64:   base64Content,
+		utils.TerraformResourceSize:            len(content),
+		utils.TerraformResourceSHA256:          hex.EncodeToString(checksum[:]),
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	d.SetId(locator.String())
+
+	return nil
+}
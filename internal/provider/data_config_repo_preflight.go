@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceConfigRepoPreflight() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConfigRepoPreflightRead,
+		Schema: map[string]*schema.Schema{
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The ID of the config repo plugin to be used for checking the files, ex: yaml.config.plugin.",
+			},
+			"profile_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "The identifier of the config repository the files belong to, when set the files are checked along with the rest of its definitions.",
+			},
+			"files": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Computed:    false,
+				MinItems:    1,
+				Description: "The list of config repo definition files to be checked.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the file, the config repo plugins identify the files by its extension ex: build.gocd.yaml.",
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The content of the file, could be read using the function file.",
+						},
+					},
+				},
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the files passed the preflight check.",
+			},
+			"errors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of errors reported by GoCD for the files.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the file the error refers to, set only when the error mentions it.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The error message reported by GoCD.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceConfigRepoPreflightRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	preflightClient := meta.(gocdclient.ConfigRepoPreflightClient)
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		newID, err := utils.GetRandomID()
+		if err != nil {
+			return diag.Errorf("errored while fetching randomID %v", err)
+		}
+
+		id = newID
+	}
+
+	files := getPreflightFiles(d.Get(utils.TerraformResourceFiles))
+	pluginID := utils.String(d.Get(utils.TerraformResourcePluginID))
+
+	response, err := preflightClient.ConfigRepoPreflightRaw(files, pluginID, utils.String(d.Get(utils.TerraformResourceProfileID)))
+	if err != nil {
+		return diag.Errorf("running preflight check with plugin '%s' errored with: %v", pluginID, err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceValid:  response.Valid && len(response.Errors) == 0,
+		utils.TerraformResourceErrors: flattenPreflightErrors(response.Errors, files),
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+func getPreflightFiles(configs any) []gocdclient.PreflightFile {
+	files := make([]gocdclient.PreflightFile, 0)
+
+	for _, config := range configs.([]any) {
+		file := config.(map[string]any)
+		files = append(files, gocdclient.PreflightFile{
+			Name:    utils.String(file[utils.TerraformResourceName]),
+			Content: utils.String(file[utils.TerraformResourceContent]),
+		})
+	}
+
+	return files
+}
+
+// flattenPreflightErrors maps the errors to the files they mention, as GoCD reports them as plain messages.
+// When the message mentions more than one of the files, the one mentioned first is preferred.
+func flattenPreflightErrors(preflightErrors []string, files []gocdclient.PreflightFile) []map[string]any {
+	flattened := make([]map[string]any, 0, len(preflightErrors))
+
+	for _, message := range preflightErrors {
+		fileName, fileIndex := "", -1
+
+		for _, file := range files {
+			index := fileMentionIndex(message, file.Name)
+			if index < 0 {
+				continue
+			}
+
+			if fileIndex < 0 || index < fileIndex || (index == fileIndex && len(file.Name) > len(fileName)) {
+				fileName, fileIndex = file.Name, index
+			}
+		}
+
+		flattened = append(flattened, map[string]any{
+			utils.TerraformResourceFile:    fileName,
+			utils.TerraformResourceMessage: message,
+		})
+	}
+
+	return flattened
+}
+
+// fileMentionIndex returns the index at which the message mentions the file name as a whole, or -1 when it does not,
+// so that build.gocd.yaml is not matched within prebuild.gocd.yaml.
+func fileMentionIndex(message, fileName string) int {
+	if len(fileName) == 0 {
+		return -1
+	}
+
+	for offset := 0; offset < len(message); {
+		index := strings.Index(message[offset:], fileName)
+		if index < 0 {
+			return -1
+		}
+
+		start, end := offset+index, offset+index+len(fileName)
+
+		// a period ending the sentence right after the file name is not part of it.
+		rest := message[end:]
+		if strings.HasPrefix(rest, ".") {
+			if next, _ := utf8.DecodeRuneInString(rest[1:]); len(rest) == 1 || !isFileNameRune(next) {
+				rest = rest[1:]
+			}
+		}
+
+		before, _ := utf8.DecodeLastRuneInString(message[:start])
+		after, _ := utf8.DecodeRuneInString(rest)
+
+		if (start == 0 || !isFileNameRune(before)) && (len(rest) == 0 || !isFileNameRune(after)) {
+			return start
+		}
+
+		offset = start + 1
+	}
+
+	return -1
+}
+
+func isFileNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("._-", r)
+}
@@ -0,0 +1,40 @@
+//nolint:testpackage
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+)
+
+func TestFlattenPreflightErrors(t *testing.T) {
+	files := []gocdclient.PreflightFile{
+		{Name: "build.gocd.yaml"},
+		{Name: "prebuild.gocd.yaml"},
+		{Name: "deploy.gocd.yaml"},
+		{Name: "ci/deploy.gocd.yaml"},
+	}
+
+	preflightErrors := []string{
+		"deploy.gocd.yaml; Failed to parse pipeline deploy; expected field 'stages' to be a list",
+		"Pipeline 'build' refers to an unknown pipeline group",
+		"prebuild.gocd.yaml; Failed to parse pipeline prebuild; unknown task type",
+		"Failed to parse build.gocd.yaml: duplicate pipeline name build, also defined in prebuild.gocd.yaml.",
+		"ci/deploy.gocd.yaml; Failed to parse pipeline deploy; unknown material type",
+		"Duplicate pipeline name deploy, defined in prebuild.gocd.yaml.",
+	}
+
+	expected := []map[string]any{
+		{"file": "deploy.gocd.yaml", "message": preflightErrors[0]},
+		{"file": "", "message": preflightErrors[1]},
+		{"file": "prebuild.gocd.yaml", "message": preflightErrors[2]},
+		{"file": "build.gocd.yaml", "message": preflightErrors[3]},
+		{"file": "ci/deploy.gocd.yaml", "message": preflightErrors[4]},
+		{"file": "prebuild.gocd.yaml", "message": preflightErrors[5]},
+	}
+
+	if actual := flattenPreflightErrors(preflightErrors, files); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
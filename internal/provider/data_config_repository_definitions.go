@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceConfigRepositoryDefinitions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConfigRepositoryDefinitionsRead,
+		Schema: map[string]*schema.Schema{
+			"profile_id": configRepoSchema()[utils.TerraformResourceProfileID],
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of pipeline groups defined by the config repository.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the pipeline group.",
+						},
+						"pipelines": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The list of pipelines defined by the config repository under the pipeline group.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"pipelines": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of all pipelines defined by the config repository, across the pipeline groups.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"environments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of environments defined by the config repository.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceConfigRepositoryDefinitionsRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	profileID := utils.String(d.Get(utils.TerraformResourceProfileID))
+
+	response, err := defaultConfig.GetConfigRepoDefinitions(profileID)
+	if err != nil {
+		return diag.Errorf("getting definitions of config repo %s errored with: %v", profileID, err)
+	}
+
+	for attribute, value := range flattenConfigRepoDefinitions(response) {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	d.SetId(profileID)
+
+	return nil
+}
+
+// flattenConfigRepoDefinitions flattens the pipeline groups, pipelines and environments defined by the config repo.
+func flattenConfigRepoDefinitions(definitions gocd.ConfigRepo) map[string]any {
+	groups := make([]map[string]any, 0, len(definitions.Groups))
+	pipelines := make([]string, 0)
+
+	for _, group := range definitions.Groups {
+		groupPipelines := make([]string, 0, len(group.Pipelines))
+		for _, pipeline := range group.Pipelines {
+			groupPipelines = append(groupPipelines, pipeline.Name)
+		}
+
+		groups = append(groups, map[string]any{
+			utils.TerraformResourceName:      group.Name,
+			utils.TerraformResourcePipelines: groupPipelines,
+		})
+
+		pipelines = append(pipelines, groupPipelines...)
+	}
+
+	environments := make([]string, 0, len(definitions.Environments))
+	for _, environment := range definitions.Environments {
+		environments = append(environments, environment.Name)
+	}
+
+	return map[string]any{
+		utils.TerraformResourceGroups:       groups,
+		utils.TerraformResourcePipelines:    pipelines,
+		utils.TerraformResourceEnvironments: environments,
+	}
+}
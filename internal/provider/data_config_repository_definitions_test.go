@@ -0,0 +1,82 @@
+//nolint:testpackage
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+// configRepoDefinitionsClient serves the definitions of the config repo.
+type configRepoDefinitionsClient struct {
+	gocd.GoCd
+	definitions gocd.ConfigRepo
+}
+
+func (client configRepoDefinitionsClient) GetConfigRepoDefinitions(_ string) (gocd.ConfigRepo, error) {
+	return client.definitions, nil
+}
+
+func TestFlattenConfigRepoDefinitions(t *testing.T) {
+	definitions := gocd.ConfigRepo{
+		Groups: []gocd.PipelineGroup{
+			{Name: "action-movies", Pipelines: []gocd.Pipeline{{Name: "helm-images"}, {Name: "helm-drift"}}},
+			{Name: "empty-group"},
+			{Name: "animated-movies", Pipelines: []gocd.Pipeline{{Name: "gocd-prometheus-exporter"}}},
+		},
+		Environments: []gocd.Environment{{Name: "production"}, {Name: "staging"}},
+	}
+
+	tests := map[string]struct {
+		definitions gocd.ConfigRepo
+		expected    map[string]any
+	}{
+		"groups, pipelines and environments": {
+			definitions: definitions,
+			expected: map[string]any{
+				"groups": []map[string]any{
+					{"name": "action-movies", "pipelines": []string{"helm-images", "helm-drift"}},
+					{"name": "empty-group", "pipelines": []string{}},
+					{"name": "animated-movies", "pipelines": []string{"gocd-prometheus-exporter"}},
+				},
+				"pipelines":    []string{"helm-images", "helm-drift", "gocd-prometheus-exporter"},
+				"environments": []string{"production", "staging"},
+			},
+		},
+		"nothing defined": {
+			definitions: gocd.ConfigRepo{},
+			expected: map[string]any{
+				"groups":       []map[string]any{},
+				"pipelines":    []string{},
+				"environments": []string{},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := flattenConfigRepoDefinitions(tt.definitions); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected definitions %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+
+	t.Run("definitions set on the data source", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, dataSourceConfigRepositoryDefinitions().Schema, map[string]any{"profile_id": "sample-config-repo"})
+
+		if diags := dataSourceConfigRepositoryDefinitionsRead(context.Background(), d, configRepoDefinitionsClient{definitions: definitions}); diags.HasError() {
+			t.Fatalf("expected definitions to be read, got: %v", diags)
+		}
+
+		if pipelines := d.Get("groups.0.pipelines"); !reflect.DeepEqual(pipelines, []any{"helm-images", "helm-drift"}) {
+			t.Errorf("expected pipelines of the first group to be set, got %v", pipelines)
+		}
+
+		if environments := d.Get("environments"); !reflect.DeepEqual(environments, []any{"production", "staging"}) {
+			t.Errorf("expected environments to be set, got %v", environments)
+		}
+	})
+}
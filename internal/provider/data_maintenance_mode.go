@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceMaintenanceMode() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceMaintenanceModeRead,
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether GoCD is in maintenance mode.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user who last enabled/disabled the maintenance mode.",
+			},
+			"updated_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the maintenance mode was last enabled/disabled.",
+			},
+			"has_running_systems": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether there are builds or material updates still running in GoCD.",
+			},
+			"building_jobs": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of jobs still building.",
+			},
+			"scheduled_jobs": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of jobs scheduled and waiting for an agent.",
+			},
+			"material_updates_in_progress": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of material updates in progress.",
+			},
+		},
+	}
+}
+
+func datasourceMaintenanceModeRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	maintenanceClient := meta.(gocdclient.MaintenanceModeClient)
+
+	response, err := maintenanceClient.GetMaintenanceModeRaw()
+	if err != nil {
+		return diag.Errorf("getting maintenance mode information errored with: %v", err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceEnabled:           response.Enabled,
+		utils.TerraformResourceUpdatedBy:         response.UpdatedBy,
+		utils.TerraformResourceUpdatedOn:         response.UpdatedOn,
+		utils.TerraformResourceHasRunningSystems: response.HasRunningSystems,
+		utils.TerraformResourceBuildingJobs:      response.BuildingJobs,
+		utils.TerraformResourceScheduledJobs:     response.ScheduledJobs,
+		utils.TerraformResourceMaterialUpdates:   response.MaterialUpdatesInProgress,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	d.SetId(maintenanceModeID)
+
+	return nil
+}
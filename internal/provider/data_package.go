@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourcePackage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourcePackageRead,
+		Schema: map[string]*schema.Schema{
+			"package_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The identifier of the package.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the package.",
+			},
+			"repo_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the package repository to which the package belongs.",
+			},
+			"auto_update": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether GoCD polls the package repository for new versions of the package.",
+			},
+			"configuration": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of configuration properties that represent the configuration of the package.",
+				Elem:        propertiesSchemaData(),
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Etag used to track the package.",
+			},
+		},
+	}
+}
+
+func datasourcePackageRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		resourceID := utils.String(d.Get(utils.TerraformResourcePackageID))
+		id = resourceID
+	}
+
+	packageID := utils.String(d.Get(utils.TerraformResourcePackageID))
+
+	response, err := defaultConfig.GetPackage(packageID)
+	if err != nil {
+		return diag.Errorf("getting package '%s' errored with: %v", packageID, err)
+	}
+
+	flattenedConfiguration, err := utils.MapSlice(response.Configuration)
+	if err != nil {
+		return diag.Errorf("errored while flattening package configuration obtained: %v", err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceName:          response.Name,
+		utils.TerraformResourceRepoID:        response.PackageRepos.ID,
+		utils.TerraformResourceAutoUpdate:    response.AutoUpdate,
+		utils.TerraformResourceConfiguration: flattenedConfiguration,
+		utils.TerraformResourceEtag:          response.ETAG,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	d.SetId(id)
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourcePackageRepository() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourcePackageRepositoryRead,
+		Schema: map[string]*schema.Schema{
+			"repo_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The identifier of the package repository.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the package repository.",
+			},
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the package material plugin used by the package repository.",
+			},
+			"plugin_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the package material plugin used by the package repository.",
+			},
+			"configuration": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of configuration properties that represent the configuration of the package repository.",
+				Elem:        propertiesSchemaData(),
+			},
+			"packages": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of packages defined in the package repository.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"package_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the package.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the package.",
+						},
+					},
+				},
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Etag used to track the package repository.",
+			},
+		},
+	}
+}
+
+func datasourcePackageRepositoryRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		resourceID := utils.String(d.Get(utils.TerraformResourceRepoID))
+		id = resourceID
+	}
+
+	repoID := utils.String(d.Get(utils.TerraformResourceRepoID))
+
+	response, err := defaultConfig.GetPackageRepository(repoID)
+	if err != nil {
+		return diag.Errorf("getting package repository '%s' errored with: %v", repoID, err)
+	}
+
+	flattenedConfiguration, err := utils.MapSlice(response.Configuration)
+	if err != nil {
+		return diag.Errorf("errored while flattening package repository configuration obtained: %v", err)
+	}
+
+	packages := make([]map[string]any, 0, len(response.Packages.Packages))
+	for _, pkg := range response.Packages.Packages {
+		packages = append(packages, map[string]any{
+			utils.TerraformResourcePackageID: pkg.ID,
+			utils.TerraformResourceName:      pkg.Name,
+		})
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceName:          response.Name,
+		utils.TerraformResourcePluginID:      response.PluginMetaData[pluginMetadataID],
+		utils.TerraformResourcePluginVersion: response.PluginMetaData[pluginMetadataVersion],
+		utils.TerraformResourceConfiguration: flattenedConfiguration,
+		utils.TerraformResourcePackages:      packages,
+		utils.TerraformResourceEtag:          response.ETAG,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	d.SetId(id)
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const defaultPipelineHistoryPageSize = 10
+
+func dataSourcePipelineHistory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourcePipelineHistoryRead,
+		Schema: map[string]*schema.Schema{
+			"pipeline": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the pipeline.",
+			},
+			"page_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     false,
+				Default:      defaultPipelineHistoryPageSize,
+				ValidateFunc: validation.IntBetween(gocdclient.MinPipelineHistoryPageSize, gocdclient.MaxPipelineHistoryPageSize),
+				Description:  "The number of pipeline instances to be fetched, GoCD allows 10 to 100 instances per page.",
+			},
+			"after": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "The cursor of the page to be fetched, latest instances are fetched when not set. Use next_cursor of the previous page to fetch the next page.",
+			},
+			"instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The instances of the pipeline in the page, latest first.",
+				Elem: &schema.Resource{
+					Schema: pipelineInstanceSchema(),
+				},
+			},
+			"next_cursor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cursor to be set as after to fetch the next page, empty when it is the last page.",
+			},
+		},
+	}
+}
+
+func datasourcePipelineHistoryRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	runClient := meta.(gocdclient.PipelineRunClient)
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		newID, err := utils.GetRandomID()
+		if err != nil {
+			return diag.Errorf("errored while fetching randomID %v", err)
+		}
+
+		id = newID
+	}
+
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+
+	response, err := runClient.GetPipelineHistoryRaw(pipeline, d.Get(utils.TerraformResourcePageSize).(int), utils.String(d.Get(utils.TerraformResourceAfter)))
+	if err != nil {
+		return diag.Errorf("getting history of pipeline '%s' errored with: %v", pipeline, err)
+	}
+
+	instances := make([]map[string]any, 0, len(response.Pipelines))
+	for _, instance := range response.Pipelines {
+		instances = append(instances, flattenPipelineInstance(instance))
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceInstances:  instances,
+		utils.TerraformResourceNextCursor: response.NextCursor(),
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	d.SetId(id)
+
+	return nil
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const maxPipelineHistoryPages = 3
+
+func dataSourcePipelineInstance() *schema.Resource {
+	pipelineInstance := pipelineInstanceSchema()
+
+	pipelineInstance["pipeline"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Computed:    false,
+		Description: "The name of the pipeline.",
+	}
+	pipelineInstance["counter"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{utils.TerraformResourceCounter, utils.TerraformResourceLatest, utils.TerraformResourceLatestPassed},
+		Description:  "The counter of the pipeline instance to be fetched.",
+	}
+	pipelineInstance["latest"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    false,
+		Description: "Enable to fetch the latest instance of the pipeline, irrespective of its result.",
+	}
+	pipelineInstance["latest_passed"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    false,
+		Description: "Enable to fetch the latest instance of the pipeline in which all the stages passed.",
+	}
+
+	return &schema.Resource{
+		ReadContext: datasourcePipelineInstanceRead,
+		Schema:      pipelineInstance,
+	}
+}
+
+func pipelineInstanceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"counter": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The counter of the pipeline instance.",
+		},
+		"label": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The label of the pipeline instance.",
+		},
+		"result": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The result of the pipeline instance. Can be one of `Building`, `Passed`, `Failed`, `Cancelled`, `Waiting`.",
+		},
+		"scheduled_date": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The time at which the pipeline instance was scheduled, in milliseconds since epoch.",
+		},
+		"triggered_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The user who triggered the pipeline instance, `changes` when triggered by the material changes.",
+		},
+		"trigger_message": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The message describing what triggered the pipeline instance.",
+		},
+		"trigger_forced": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the pipeline instance was triggered manually.",
+		},
+		"material_revisions": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The revisions of the materials the pipeline instance was run with.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"fingerprint": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The fingerprint of the material.",
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The type of the material.",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The description of the material, ex: URL and branch of the git material.",
+					},
+					"changed": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the material changed since the previous instance of the pipeline.",
+					},
+					"revision": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The latest revision of the material used, for upstream pipelines it is in the form pipeline/counter/stage/counter.",
+					},
+					"modified_by": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The user who made the latest modification of the material.",
+					},
+					"comment": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The comment of the latest modification of the material.",
+					},
+				},
+			},
+		},
+		"stages": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The stages of the pipeline instance along with their jobs.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the stage.",
+					},
+					"counter": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The counter of the stage instance.",
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The status of the stage instance.",
+					},
+					"result": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The result of the stage instance, empty when the stage is yet to be scheduled.",
+					},
+					"approved_by": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The user who approved the stage instance, `changes` when approved automatically.",
+					},
+					"jobs": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "The jobs of the stage instance.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The name of the job.",
+								},
+								"state": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The state of the job instance.",
+								},
+								"result": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The result of the job instance.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourcePipelineInstanceRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	runClient := meta.(gocdclient.PipelineRunClient)
+
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+
+	var (
+		instance gocdclient.PipelineInstance
+		err      error
+	)
+
+	switch {
+	case utils.Bool(d.Get(utils.TerraformResourceLatest)):
+		instance, err = getLatestPipelineInstance(runClient, pipeline, false)
+	case utils.Bool(d.Get(utils.TerraformResourceLatestPassed)):
+		instance, err = getLatestPipelineInstance(runClient, pipeline, true)
+	default:
+		instance, err = runClient.GetPipelineInstanceRaw(pipeline, d.Get(utils.TerraformResourceCounter).(int))
+	}
+
+	if err != nil {
+		return diag.Errorf("getting instance of pipeline '%s' errored with: %v", pipeline, err)
+	}
+
+	for attribute, value := range flattenPipelineInstance(instance) {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", pipeline, instance.Counter))
+
+	return nil
+}
+
+// getLatestPipelineInstance walks through the pipeline history for the latest instance, optionally the latest one that passed.
+// The walk is limited to maxPipelineHistoryPages, so that a pipeline that never passed does not page through its entire history.
+func getLatestPipelineInstance(runClient gocdclient.PipelineRunClient, pipeline string, passed bool) (gocdclient.PipelineInstance, error) {
+	after, pageSize := "", gocdclient.MinPipelineHistoryPageSize
+	if passed {
+		pageSize = gocdclient.MaxPipelineHistoryPageSize
+	}
+
+	for page := 0; page < maxPipelineHistoryPages; page++ {
+		history, err := runClient.GetPipelineHistoryRaw(pipeline, pageSize, after)
+		if err != nil {
+			return gocdclient.PipelineInstance{}, err
+		}
+
+		for _, instance := range history.Pipelines {
+			if !passed {
+				return instance, nil
+			}
+
+			if result, _ := getPipelineRunResult(instance); result == pipelineRunResultPassed {
+				return instance, nil
+			}
+		}
+
+		if after = history.NextCursor(); len(after) == 0 {
+			if passed {
+				return gocdclient.PipelineInstance{}, fmt.Errorf("no instance of the pipeline has passed yet")
+			}
+
+			return gocdclient.PipelineInstance{}, fmt.Errorf("the pipeline has not run yet")
+		}
+	}
+
+	return gocdclient.PipelineInstance{}, fmt.Errorf("no passed instance found in the latest %d instances of the pipeline",
+		maxPipelineHistoryPages*pageSize)
+}
+
+func flattenPipelineInstance(instance gocdclient.PipelineInstance) map[string]any {
+	result, _ := getPipelineRunResult(instance)
+
+	materialRevisions := make([]map[string]any, 0, len(instance.BuildCause.MaterialRevisions))
+	for _, materialRevision := range instance.BuildCause.MaterialRevisions {
+		flattenedRevision := map[string]any{
+			utils.TerraformResourceFgPrint:     materialRevision.Material.Fingerprint,
+			utils.TerraformResourceType:        materialRevision.Material.Type,
+			utils.TerraformResourceDescription: materialRevision.Material.Description,
+			utils.TerraformResourceChanged:     materialRevision.Changed,
+		}
+
+		// modifications are listed latest first, the latest one is the revision the pipeline instance was run with.
+		if len(materialRevision.Modifications) != 0 {
+			flattenedRevision[utils.TerraformResourceRevision] = materialRevision.Modifications[0].Revision
+			flattenedRevision[utils.TerraformResourceModifiedBy] = materialRevision.Modifications[0].UserName
+			flattenedRevision[utils.TerraformResourceComment] = materialRevision.Modifications[0].Comment
+		}
+
+		materialRevisions = append(materialRevisions, flattenedRevision)
+	}
+
+	stages := make([]map[string]any, 0, len(instance.Stages))
+	for _, stage := range instance.Stages {
+		jobs := make([]map[string]any, 0, len(stage.Jobs))
+		for _, job := range stage.Jobs {
+			jobs = append(jobs, map[string]any{
+				utils.TerraformResourceName:   job.Name,
+				utils.TerraformResourceState:  job.State,
+				utils.TerraformResourceResult: job.Result,
+			})
+		}
+
+		stages = append(stages, map[string]any{
+			utils.TerraformResourceName:        stage.Name,
+			utils.TerraformResourceCounter:     stage.Counter.String(),
+			utils.TerraformResourceStageStatus: stage.Status,
+			utils.TerraformResourceResult:      stage.Result,
+			utils.TerraformResourceApprovedBy:  stage.ApprovedBy,
+			utils.TerraformResourceJobs:        jobs,
+		})
+	}
+
+	return map[string]any{
+		utils.TerraformResourceCounter:           instance.Counter,
+		utils.TerraformResourceLabel:             instance.Label,
+		utils.TerraformResourceResult:            result,
+		utils.TerraformResourceScheduledDate:     instance.ScheduledDate,
+		utils.TerraformResourceTriggeredBy:       instance.BuildCause.Approver,
+		utils.TerraformResourceTriggerMessage:    instance.BuildCause.TriggerMessage,
+		utils.TerraformResourceTriggerForced:     instance.BuildCause.TriggerForced,
+		utils.TerraformResourceMaterialRevisions: materialRevisions,
+		utils.TerraformResourceStages:            stages,
+	}
+}
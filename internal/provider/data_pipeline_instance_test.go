@@ -0,0 +1,74 @@
+//nolint:testpackage
+package provider
+
+import (
+	"testing"
+
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+)
+
+type pipelineHistoryPages map[string]gocdclient.PipelineInstances
+
+func (pages pipelineHistoryPages) SchedulePipelineRaw(_ string, _ map[string]any) error {
+	return nil
+}
+
+func (pages pipelineHistoryPages) GetPipelineInstanceRaw(_ string, _ int) (gocdclient.PipelineInstance, error) {
+	return gocdclient.PipelineInstance{}, nil
+}
+
+func (pages pipelineHistoryPages) GetPipelineHistoryRaw(_ string, _ int, after string) (gocdclient.PipelineInstances, error) {
+	return pages[after], nil
+}
+
+func TestGetLatestPipelineInstance(t *testing.T) {
+	passed := []gocdclient.StageInstance{{Name: "build", Scheduled: true, Result: "Passed"}}
+	failed := []gocdclient.StageInstance{{Name: "build", Scheduled: true, Result: "Failed"}}
+
+	pages := pipelineHistoryPages{
+		"": {
+			Links:     map[string]any{"next": map[string]any{"href": "https://gocd.example.com/go/api/pipelines/helm-images/history?after=3"}},
+			Pipelines: []gocdclient.PipelineInstance{{Counter: 4, Stages: failed}, {Counter: 3, Stages: failed}},
+		},
+		"3": {
+			Pipelines: []gocdclient.PipelineInstance{{Counter: 2, Stages: passed}, {Counter: 1, Stages: passed}},
+		},
+	}
+
+	t.Run("latest instance", func(t *testing.T) {
+		instance, err := getLatestPipelineInstance(pages, "helm-images", false)
+		if err != nil || instance.Counter != 4 {
+			t.Errorf("expected instance 4, got %d with error: %v", instance.Counter, err)
+		}
+	})
+
+	t.Run("latest passed instance from the next page", func(t *testing.T) {
+		instance, err := getLatestPipelineInstance(pages, "helm-images", true)
+		if err != nil || instance.Counter != 2 {
+			t.Errorf("expected instance 2, got %d with error: %v", instance.Counter, err)
+		}
+	})
+
+	t.Run("pipeline without a passed instance", func(t *testing.T) {
+		if _, err := getLatestPipelineInstance(pipelineHistoryPages{"": pages[""]}, "helm-images", true); err == nil {
+			t.Errorf("expected an error when no instance has passed")
+		}
+	})
+
+	t.Run("history walk is limited when no instance has passed", func(t *testing.T) {
+		endless := pipelineHistoryPages{
+			"": {
+				Links:     map[string]any{"next": map[string]any{"href": "https://gocd.example.com/go/api/pipelines/helm-images/history?after=3"}},
+				Pipelines: []gocdclient.PipelineInstance{{Counter: 4, Stages: failed}},
+			},
+			"3": {
+				Links:     map[string]any{"next": map[string]any{"href": "https://gocd.example.com/go/api/pipelines/helm-images/history?after=3"}},
+				Pipelines: []gocdclient.PipelineInstance{{Counter: 3, Stages: failed}},
+			},
+		}
+
+		if _, err := getLatestPipelineInstance(endless, "helm-images", true); err == nil {
+			t.Errorf("expected an error when the history walk exceeds %d pages", maxPipelineHistoryPages)
+		}
+	})
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const (
+	pipelineOriginGoCD       = "gocd"
+	pipelineOriginConfigRepo = "config_repo"
+)
+
+func dataSourcePipelines() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourcePipelinesRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the pipelines that are part of the pipeline group.",
+			},
+			"name_regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "Regular expression to filter the pipelines by their name.",
+			},
+			"origin": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     false,
+				ValidateFunc: validation.StringInSlice([]string{pipelineOriginGoCD, pipelineOriginConfigRepo}, false),
+				Description:  "Filter the pipelines by where they are defined. Can be one of `gocd`, `config_repo`.",
+			},
+			"pipelines": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of pipelines matching the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the pipeline.",
+						},
+						"group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the pipeline group the pipeline is part of.",
+						},
+						"template": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the template the pipeline is based on, if any.",
+						},
+						"origin": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Where the pipeline is defined. Can be one of `gocd`, `config_repo`.",
+						},
+						"config_repo_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the config repository defining the pipeline, set only when the origin is `config_repo`.",
+						},
+						"paused": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the pipeline is paused.",
+						},
+						"material_fingerprints": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The fingerprints of the materials used by the pipeline.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourcePipelinesRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		newID, err := utils.GetRandomID()
+		if err != nil {
+			return diag.Errorf("errored while fetching randomID %v", err)
+		}
+
+		id = newID
+	}
+
+	var nameRegex *regexp.Regexp
+
+	if pattern := utils.String(d.Get(utils.TerraformResourceNameRegex)); len(pattern) != 0 {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return diag.Errorf("compiling name_regex '%s' errored with: %v", pattern, err)
+		}
+
+		nameRegex = regex
+	}
+
+	groups, err := defaultConfig.GetPipelineGroups()
+	if err != nil {
+		return diag.Errorf("getting pipeline groups errored with: %v", err)
+	}
+
+	group := utils.String(d.Get(utils.TerraformResourceGroup))
+
+	matchedGroups := make(map[string]string)
+
+	for _, pipelineGroup := range groups {
+		if len(group) != 0 && pipelineGroup.Name != group {
+			continue
+		}
+
+		for _, pipeline := range pipelineGroup.Pipelines {
+			if nameRegex != nil && !nameRegex.MatchString(pipeline.Name) {
+				continue
+			}
+
+			matchedGroups[pipeline.Name] = pipelineGroup.Name
+		}
+	}
+
+	pipelines := make([]map[string]any, 0)
+
+	if len(matchedGroups) != 0 {
+		if pipelines, err = getFilteredPipelines(meta, matchedGroups, utils.String(d.Get(utils.TerraformResourceOrigin))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err = d.Set(utils.TerraformResourcePipelines, pipelines); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourcePipelines, err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+// getFilteredPipelines fetches the details of the pipelines matched by the group and name, filtering them by the origin.
+// The state and origin of all the pipelines are fetched from the dashboard at once, so that the config is fetched
+// only for the pipelines that match all the filters. State of the pipelines missing from the dashboard is fetched individually.
+func getFilteredPipelines(meta any, matchedGroups map[string]string, origin string) ([]map[string]any, error) {
+	defaultConfig := meta.(gocd.GoCd)
+
+	// the dashboard is not available on GoCD versions older than 20.1.0, the state of the pipelines is then fetched individually.
+	dashboard, err := meta.(gocdclient.DashboardClient).GetDashboardPipelinesRaw()
+	if err != nil {
+		log.Printf("getting dashboard errored with: %v, fetching the state of the pipelines individually", err)
+	}
+
+	names := make([]string, 0, len(matchedGroups))
+	for name := range matchedGroups {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	var materials []gocd.Material
+
+	pipelines := make([]map[string]any, 0, len(names))
+
+	for _, name := range names {
+		// pipelines hidden from the dashboard by the personalization of the user are filtered after fetching their config.
+		state, listed := dashboard[name]
+		if listed && len(origin) != 0 && state.FromConfigRepo != (origin == pipelineOriginConfigRepo) {
+			continue
+		}
+
+		pipelineConfig, err := defaultConfig.GetPipelineConfig(name)
+		if err != nil {
+			return nil, fmt.Errorf("getting pipeline %s errored with: %w", name, err)
+		}
+
+		pipelineOrigin := pipelineOriginGoCD
+		if pipelineConfig.Origin.Type == pipelineOriginConfigRepo {
+			pipelineOrigin = pipelineOriginConfigRepo
+		}
+
+		if len(origin) != 0 && pipelineOrigin != origin {
+			continue
+		}
+
+		var configRepoID string
+		if pipelineOrigin == pipelineOriginConfigRepo {
+			configRepoID = pipelineConfig.Origin.ID
+		}
+
+		paused := state.Paused
+		if !listed {
+			pipelineState, err := defaultConfig.GetPipelineState(name)
+			if err != nil {
+				return nil, fmt.Errorf("getting state of pipeline %s errored with: %w", name, err)
+			}
+
+			paused = pipelineState.Paused
+		}
+
+		if materials == nil {
+			if materials, err = defaultConfig.GetMaterials(); err != nil {
+				return nil, fmt.Errorf("getting materials errored with: %w", err)
+			}
+		}
+
+		pipelines = append(pipelines, map[string]any{
+			utils.TerraformResourceName:         name,
+			utils.TerraformResourceGroup:        matchedGroups[name],
+			utils.TerraformResourceTemplate:     pipelineConfig.Template,
+			utils.TerraformResourceOrigin:       pipelineOrigin,
+			utils.TerraformResourceConfigRepoID: configRepoID,
+			utils.TerraformResourcePaused:       paused,
+			utils.TerraformResourceFingerprints: getPipelineMaterialFingerprints(materials, pipelineConfig.Materials),
+		})
+	}
+
+	return pipelines, nil
+}
+
+// getPipelineMaterialFingerprints looks up the fingerprints of the materials used by the pipeline from the materials known to GoCD,
+// as the pipeline config does not carry the fingerprints of its materials.
+func getPipelineMaterialFingerprints(materials, pipelineMaterials []gocd.Material) []string {
+	fingerprints := make(map[string]string, len(materials))
+	for _, material := range materials {
+		fingerprints[materialKey(material.Config.Type, material.Config.Attributes)] = material.Config.Fingerprint
+	}
+
+	pipelineFingerprints := make([]string, 0, len(pipelineMaterials))
+
+	for _, material := range pipelineMaterials {
+		fingerprint, ok := fingerprints[materialKey(material.Type, material.Attributes)]
+		if !ok {
+			log.Printf("material '%s' of type '%s' is not known to GoCD yet, skipping its fingerprint", material.Attributes.Name, material.Type)
+
+			continue
+		}
+
+		if !slices.Contains(pipelineFingerprints, fingerprint) {
+			pipelineFingerprints = append(pipelineFingerprints, fingerprint)
+		}
+	}
+
+	slices.Sort(pipelineFingerprints)
+
+	return pipelineFingerprints
+}
+
+// materialKey identifies the material by the attributes GoCD derives its fingerprint from.
+func materialKey(materialType string, attributes gocd.Attribute) string {
+	return strings.Join([]string{
+		materialType, attributes.URL, attributes.Branch, attributes.Username, attributes.Port, attributes.View,
+		attributes.ProjectPath, attributes.Domain, attributes.Ref, attributes.Pipeline, attributes.Stage,
+	}, "|")
+}
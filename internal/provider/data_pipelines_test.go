@@ -0,0 +1,138 @@
+//nolint:testpackage
+package provider
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+)
+
+// pipelinesClient serves the pipelines from the dashboard when set, else fails the dashboard like GoCD older than 20.1.0.
+type pipelinesClient struct {
+	gocd.GoCd
+	dashboard map[string]gocdclient.DashboardPipeline
+	states    map[string]gocd.PipelineState
+	configs   map[string]gocd.PipelineConfig
+}
+
+func (client pipelinesClient) GetDashboardPipelinesRaw() (map[string]gocdclient.DashboardPipeline, error) {
+	if client.dashboard == nil {
+		return nil, errors.New("dashboard is not supported by the GoCD server")
+	}
+
+	return client.dashboard, nil
+}
+
+func (client pipelinesClient) GetPipelineState(pipeline string) (gocd.PipelineState, error) {
+	state, ok := client.states[pipeline]
+	if !ok {
+		return gocd.PipelineState{}, errors.New("state of pipeline " + pipeline + " is not expected to be fetched")
+	}
+
+	return state, nil
+}
+
+func (client pipelinesClient) GetPipelineConfig(name string) (gocd.PipelineConfig, error) {
+	return client.configs[name], nil
+}
+
+func (client pipelinesClient) GetMaterials() ([]gocd.Material, error) {
+	return []gocd.Material{}, nil
+}
+
+func TestGetPipelineMaterialFingerprints(t *testing.T) {
+	gitMaterial := gocd.Attribute{URL: "https://github.com/nikhilsbhat/helm-drift.git", Branch: "master", AutoUpdate: true}
+	dependencyMaterial := gocd.Attribute{Pipeline: "helm-images", Stage: "build"}
+
+	materials := []gocd.Material{
+		{Config: gocd.MaterialConfig{Type: "git", Fingerprint: "f1a2", Attributes: gitMaterial}},
+		{Config: gocd.MaterialConfig{Type: "git", Fingerprint: "b3c4", Attributes: gocd.Attribute{URL: gitMaterial.URL, Branch: "main"}}},
+		{Config: gocd.MaterialConfig{Type: "dependency", Fingerprint: "d5e6", Attributes: dependencyMaterial}},
+	}
+
+	pipelineMaterials := []gocd.Material{
+		{Type: "dependency", Attributes: dependencyMaterial},
+		// materials sharing the fingerprint differ only in the attributes not part of the fingerprint.
+		{Type: "git", Attributes: gocd.Attribute{URL: gitMaterial.URL, Branch: "master", Destination: "helm-drift"}},
+		{Type: "git", Attributes: gocd.Attribute{URL: gitMaterial.URL, Branch: "master", Destination: "charts"}},
+		{Type: "hg", Attributes: gocd.Attribute{URL: "https://hg.example.com/helm-drift"}},
+	}
+
+	expected := []string{"d5e6", "f1a2"}
+	if actual := getPipelineMaterialFingerprints(materials, pipelineMaterials); !slices.Equal(actual, expected) {
+		t.Errorf("expected fingerprints %v, got %v", expected, actual)
+	}
+}
+
+func TestGetFilteredPipelines(t *testing.T) {
+	matchedGroups := map[string]string{"helm-images": "action-movies", "helm-drift": "action-movies"}
+	configs := map[string]gocd.PipelineConfig{
+		"helm-images": {Name: "helm-images", Origin: gocd.PipelineOrigin{Type: pipelineOriginGoCD}},
+		"helm-drift":  {Name: "helm-drift", Origin: gocd.PipelineOrigin{Type: pipelineOriginConfigRepo, ID: "sample-config-repo"}},
+	}
+
+	tests := map[string]struct {
+		client   pipelinesClient
+		origin   string
+		expected map[string]bool
+	}{
+		"state from the dashboard": {
+			client: pipelinesClient{
+				dashboard: map[string]gocdclient.DashboardPipeline{
+					"helm-images": {Name: "helm-images", Paused: true},
+					"helm-drift":  {Name: "helm-drift", FromConfigRepo: true},
+				},
+				configs: configs,
+			},
+			expected: map[string]bool{"helm-images": true, "helm-drift": false},
+		},
+		"state of pipelines hidden from the dashboard": {
+			client: pipelinesClient{
+				dashboard: map[string]gocdclient.DashboardPipeline{"helm-drift": {Name: "helm-drift", FromConfigRepo: true}},
+				states:    map[string]gocd.PipelineState{"helm-images": {Name: "helm-images", Paused: true}},
+				configs:   configs,
+			},
+			expected: map[string]bool{"helm-images": true, "helm-drift": false},
+		},
+		"state of pipelines when the dashboard is not available": {
+			client: pipelinesClient{
+				states: map[string]gocd.PipelineState{
+					"helm-images": {Name: "helm-images"},
+					"helm-drift":  {Name: "helm-drift", Paused: true},
+				},
+				configs: configs,
+			},
+			expected: map[string]bool{"helm-images": false, "helm-drift": true},
+		},
+		"state fetched only for pipelines matching the origin": {
+			client: pipelinesClient{
+				states:  map[string]gocd.PipelineState{"helm-drift": {Name: "helm-drift", Paused: true}},
+				configs: configs,
+			},
+			origin:   pipelineOriginConfigRepo,
+			expected: map[string]bool{"helm-drift": true},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			pipelines, err := getFilteredPipelines(tt.client, matchedGroups, tt.origin)
+			if err != nil {
+				t.Fatalf("expected no error, got: %v", err)
+			}
+
+			actual := make(map[string]bool, len(pipelines))
+			for _, pipeline := range pipelines {
+				actual[pipeline["name"].(string)] = pipeline["paused"].(bool)
+			}
+
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("expected paused state of the pipelines to be %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
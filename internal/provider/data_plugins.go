@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourcePlugins() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourcePluginsRead,
+		Schema: map[string]*schema.Schema{
+			"extension_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the plugins implementing the extension type, ex: elastic-agent, configrepo, scm.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the plugins by their status (case-insensitive). Can be one of active, invalid.",
+			},
+			"bundled_plugin": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the plugins that are/are not bundled with GoCD, all the plugins are listed when not set.",
+			},
+			"plugin_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The identifiers of the plugins matching the filters.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"plugins": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of plugins matching the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"plugin_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique plugin identifier.",
+						},
+						"plugin_file_location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The location where the plugin is installed.",
+						},
+						"bundled_plugin": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the plugin is bundled with GoCD.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the plugin. Can be one of active, invalid.",
+						},
+						"extensions": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "A list of extension information pertaining to the list of extensions the plugin implements.",
+							Elem: &schema.Resource{
+								Schema: pluginAttributesSchema(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourcePluginsRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		newID, err := utils.GetRandomID()
+		if err != nil {
+			return diag.Errorf("errored while fetching randomID %v", err)
+		}
+
+		id = newID
+	}
+
+	response, err := defaultConfig.GetPluginsInfo()
+	if err != nil {
+		return diag.Errorf("getting plugins information errored with: %v", err)
+	}
+
+	extensionType := utils.String(d.Get(utils.TerraformResourceExtensionType))
+	status := utils.String(d.Get(utils.TerraformResourcePluginStatus))
+	filterBundled := configHasAttribute(d, utils.TerraformResourcePluginBundled)
+
+	pluginIDs := make([]string, 0)
+	plugins := make([]map[string]any, 0)
+
+	for _, plugin := range response.Plugins {
+		if len(extensionType) != 0 && !pluginImplements(plugin, extensionType) {
+			continue
+		}
+
+		if len(status) != 0 && !strings.EqualFold(plugin.Status.State, status) {
+			continue
+		}
+
+		if filterBundled && plugin.BundledPlugin != utils.Bool(d.Get(utils.TerraformResourcePluginBundled)) {
+			continue
+		}
+
+		pluginIDs = append(pluginIDs, plugin.ID)
+		plugins = append(plugins, map[string]any{
+			utils.TerraformResourcePluginID:       plugin.ID,
+			utils.TerraformResourcePluginLocation: plugin.PluginFileLocation,
+			utils.TerraformResourcePluginBundled:  plugin.BundledPlugin,
+			utils.TerraformResourcePluginStatus:   plugin.Status.State,
+			utils.TerraformResourceExtensions:     flattenPluginExtensions(plugin.Extensions),
+		})
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourcePluginIDs: pluginIDs,
+		utils.TerraformResourcePlugins:   plugins,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+func pluginImplements(plugin *gocd.Plugin, extensionType string) bool {
+	for _, extension := range plugin.Extensions {
+		if extension.Type == extensionType {
+			return true
+		}
+	}
+
+	return false
+}
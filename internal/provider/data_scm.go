@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceSCM() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceSCMRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the SCM.",
+			},
+			"scm_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the SCM to be referred in the pipeline materials.",
+			},
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier of the SCM plugin used by the SCM.",
+			},
+			"plugin_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the SCM plugin used by the SCM.",
+			},
+			"auto_update": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether GoCD polls the SCM for new changes.",
+			},
+			"configuration": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of configuration properties that represent the configuration of the SCM.",
+				Elem:        propertiesSchemaData(),
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Etag used to track the SCM.",
+			},
+		},
+	}
+}
+
+func datasourceSCMRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	scmClient := meta.(gocdclient.SCMClient)
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		resourceID := utils.String(d.Get(utils.TerraformResourceName))
+		id = resourceID
+	}
+
+	name := utils.String(d.Get(utils.TerraformResourceName))
+
+	response, err := scmClient.GetSCM(name)
+	if err != nil {
+		return diag.Errorf("getting scm '%s' errored with: %v", name, err)
+	}
+
+	flattenedConfiguration, err := utils.MapSlice(response.Configuration)
+	if err != nil {
+		return diag.Errorf("errored while flattening scm configuration obtained: %v", err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceSCMID:         response.ID,
+		utils.TerraformResourcePluginID:      response.PluginMetadata[pluginMetadataID],
+		utils.TerraformResourcePluginVersion: response.PluginMetadata[pluginMetadataVersion],
+		utils.TerraformResourceAutoUpdate:    response.AutoUpdate,
+		utils.TerraformResourceConfiguration: flattenedConfiguration,
+		utils.TerraformResourceEtag:          response.ETAG,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	d.SetId(id)
+
+	return nil
+}
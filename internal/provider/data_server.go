@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const serverID = "gocd_server"
+
+func dataSourceServer() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceServerRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the GoCD server, ex: 23.1.0.",
+			},
+			"full_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the GoCD server along with its build number, ex: 23.1.0 (16079-...).",
+			},
+			"git_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The git sha of the commit the GoCD server is built from.",
+			},
+			"health": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health of the GoCD server as reported by its health check, ex: OK.",
+			},
+			"health_messages": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of server health messages, these are the errors and warnings shown on the GoCD dashboard.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"level": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The level of the message. Can be one of `ERROR`, `WARNING`.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The summary of the message.",
+						},
+						"detail": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The detailed description of the message.",
+						},
+						"time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time at which the message was reported.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceServerRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	versionInfo, err := defaultConfig.GetVersionInfo()
+	if err != nil {
+		return diag.Errorf("getting version of GoCD server errored with: %v", err)
+	}
+
+	health, err := defaultConfig.GetServerHealth()
+	if err != nil {
+		return diag.Errorf("getting health of GoCD server errored with: %v", err)
+	}
+
+	response, err := defaultConfig.GetServerHealthMessages()
+	if err != nil {
+		return diag.Errorf("getting server health messages errored with: %v", err)
+	}
+
+	healthMessages := make([]map[string]any, 0, len(response))
+	for _, healthMessage := range response {
+		healthMessages = append(healthMessages, map[string]any{
+			utils.TerraformResourceLevel:   healthMessage.Level,
+			utils.TerraformResourceMessage: healthMessage.Message,
+			utils.TerraformResourceDetail:  healthMessage.Detail,
+			utils.TerraformResourceTime:    healthMessage.Time,
+		})
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceVersion:        versionInfo.Version,
+		utils.TerraformResourceFullVersion:    versionInfo.FullVersion,
+		utils.TerraformResourceGitSHA:         versionInfo.GitSHA,
+		utils.TerraformResourceHealth:         health["health"],
+		utils.TerraformResourceHealthMessages: healthMessages,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	d.SetId(serverID)
+
+	return nil
+}
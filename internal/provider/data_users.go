@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceUsersRead,
+		Schema: map[string]*schema.Schema{
+			"login_name_regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "Regular expression to filter the users by their login name.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the users that are enabled/disabled, all the users are listed when not set.",
+			},
+			"is_admin": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the users that are/are not system admins, all the users are listed when not set.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "Filter the users that are part of the specified role.",
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of users matching the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"login_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The login name of the user.",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The display name of the user.",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email address of the user.",
+						},
+						"email_me": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the user receives email notifications.",
+						},
+						"checkin_aliases": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The list of aliases the user uses in the commits.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the user is enabled or not.",
+						},
+						"is_admin": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the user is a system admin or not.",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The list of roles the user is part of.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceUsersRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		newID, err := utils.GetRandomID()
+		if err != nil {
+			return diag.Errorf("errored while fetching randomID %v", err)
+		}
+
+		id = newID
+	}
+
+	var loginNameRegex *regexp.Regexp
+
+	if pattern := utils.String(d.Get("login_name_regex")); len(pattern) != 0 {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return diag.Errorf("compiling login_name_regex '%s' errored with: %v", pattern, err)
+		}
+
+		loginNameRegex = regex
+	}
+
+	response, err := defaultConfig.GetUsers()
+	if err != nil {
+		return diag.Errorf("getting users errored with: %v", err)
+	}
+
+	role := utils.String(d.Get(utils.TerraformResourceRole))
+	filterEnabled := configHasAttribute(d, utils.TerraformResourceEnabled)
+	filterAdmin := configHasAttribute(d, utils.TerraformResourceIsAdmin)
+
+	users := make([]map[string]any, 0)
+
+	for _, user := range response {
+		if loginNameRegex != nil && !loginNameRegex.MatchString(user.LoginName) {
+			continue
+		}
+
+		if filterEnabled && user.Enabled != utils.Bool(d.Get(utils.TerraformResourceEnabled)) {
+			continue
+		}
+
+		if filterAdmin && user.Admin != utils.Bool(d.Get(utils.TerraformResourceIsAdmin)) {
+			continue
+		}
+
+		roles := make([]string, 0, len(user.Roles))
+		for _, userRole := range user.Roles {
+			roles = append(roles, userRole.Name)
+		}
+
+		if len(role) != 0 && !slices.Contains(roles, role) {
+			continue
+		}
+
+		users = append(users, map[string]any{
+			utils.TerraformResourceLoginName:      user.LoginName,
+			utils.TerraformResourceDisplayName:    user.Name,
+			utils.TerraformResourceEmail:          user.EmailID,
+			utils.TerraformResourceEmailMe:        user.EmailMe,
+			utils.TerraformResourceCheckinAliases: user.CheckInAlias,
+			utils.TerraformResourceEnabled:        user.Enabled,
+			utils.TerraformResourceIsAdmin:        user.Admin,
+			utils.TerraformResourceRoles:          roles,
+		})
+	}
+
+	if err = d.Set(utils.TerraformResourceUsers, users); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceUsers, err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
@@ -82,7 +82,8 @@ func Provider() *schema.Provider {
 				Computed:    false,
 				DefaultFunc: schema.EnvDefaultFunc("GOCD_SKIP_CHECK", "false"),
 				Description: "setting this to false will skip a validation done during client creation, this helps by avoiding " +
-					"errors being thrown from all resource/data block defined",
+					"errors being thrown from all resource/data block defined. The version of the GoCD server is recorded during this validation, " +
+					"which is used to report the features not supported by older GoCD servers",
 			},
 			"retries": retrySchemas(),
 		},
@@ -99,28 +100,57 @@ func Provider() *schema.Provider {
 			"gocd_backup_config":         resourceBackupConfig(),
 			"gocd_backup_schedule":       resourceBackupSchedule(),
 			"gocd_agent":                 resourceAgentConfig(),
+			"gocd_agents":                resourceAgents(),
 			"gocd_pipeline":              resourcePipeline(),
 			"gocd_pipeline_template":     resourcePipelineTemplate(),
 			"gocd_artifact_store":        resourceArtifactStore(),
 			"gocd_role":                  resourceRole(),
 			"gocd_pipeline_group":        resourcePipelineGroup(),
+			"gocd_user":                  resourceUser(),
+			"gocd_access_token":          resourceAccessToken(),
+			"gocd_site_urls":             resourceSiteURLs(),
+			"gocd_mail_server":           resourceMailServer(),
+			"gocd_artifacts_config":      resourceArtifactsConfig(),
+			"gocd_default_job_timeout":   resourceDefaultJobTimeout(),
+			"gocd_package_repository":    resourcePackageRepository(),
+			"gocd_package":               resourcePackage(),
+			"gocd_scm":                   resourceSCM(),
+			"gocd_system_admins":         resourceSystemAdmins(),
+			"gocd_system_admin_member":   resourceSystemAdminMember(),
+			"gocd_pipeline_run":          resourcePipelineRun(),
+			"gocd_pipeline_state":        resourcePipelineState(),
+			"gocd_maintenance_mode":      resourceMaintenanceMode(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"gocd_plugin_setting":        dataSourcePluginsSetting(),
-			"gocd_auth_config":           dataSourceAuthConfig(),
-			"gocd_cluster_profile":       dataSourceClusterProfile(),
-			"gocd_elastic_agent_profile": dataSourceElasticAgentProfile(),
-			"gocd_config_repository":     dataSourceConfigRepository(),
-			"gocd_environment":           dataSourceEnvironment(),
-			"gocd_secret_config":         dataSourceSecretConfig(),
-			"gocd_plugin_info":           dataSourcePluginInfo(),
-			"gocd_agent":                 dataSourceAgentConfig(),
-			"gocd_pipeline":              dataSourcePipeline(),
-			"gocd_pipeline_template":     dataSourcePipelineTemplate(),
-			"gocd_artifact_store":        dataSourceArtifactStore(),
-			"gocd_role":                  dataSourceRole(),
-			"gocd_pipeline_group":        dataSourcePipelineGroup(),
+			"gocd_plugin_setting":                dataSourcePluginsSetting(),
+			"gocd_auth_config":                   dataSourceAuthConfig(),
+			"gocd_cluster_profile":               dataSourceClusterProfile(),
+			"gocd_elastic_agent_profile":         dataSourceElasticAgentProfile(),
+			"gocd_config_repository":             dataSourceConfigRepository(),
+			"gocd_environment":                   dataSourceEnvironment(),
+			"gocd_secret_config":                 dataSourceSecretConfig(),
+			"gocd_plugin_info":                   dataSourcePluginInfo(),
+			"gocd_agent":                         dataSourceAgentConfig(),
+			"gocd_pipeline":                      dataSourcePipeline(),
+			"gocd_pipeline_template":             dataSourcePipelineTemplate(),
+			"gocd_artifact_store":                dataSourceArtifactStore(),
+			"gocd_role":                          dataSourceRole(),
+			"gocd_pipeline_group":                dataSourcePipelineGroup(),
+			"gocd_users":                         dataSourceUsers(),
+			"gocd_package_repository":            dataSourcePackageRepository(),
+			"gocd_package":                       dataSourcePackage(),
+			"gocd_scm":                           dataSourceSCM(),
+			"gocd_maintenance_mode":              dataSourceMaintenanceMode(),
+			"gocd_config_repository_definitions": dataSourceConfigRepositoryDefinitions(),
+			"gocd_config_repo_preflight":         dataSourceConfigRepoPreflight(),
+			"gocd_agents":                        dataSourceAgents(),
+			"gocd_pipelines":                     dataSourcePipelines(),
+			"gocd_plugins":                       dataSourcePlugins(),
+			"gocd_server":                        dataSourceServer(),
+			"gocd_pipeline_instance":             dataSourcePipelineInstance(),
+			"gocd_pipeline_history":              dataSourcePipelineHistory(),
+			"gocd_artifact":                      dataSourceArtifact(),
 		},
 
 		ConfigureContextFunc: client.GetGoCDClient,
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const defaultRevokeCause = "revoked by terraform"
+
+func resourceAccessToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAccessTokenCreate,
+		ReadContext:   resourceAccessTokenRead,
+		UpdateContext: resourceAccessTokenUpdate,
+		DeleteContext: resourceAccessTokenDelete,
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The description of the access token, describing what it would be used for.",
+			},
+			"revoke_cause": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    false,
+				Default:     defaultRevokeCause,
+				Description: "The reason recorded in GoCD when the access token is revoked on destroy.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The access token, GoCD returns it only on creation hence it is available only for the tokens created by terraform.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user to whom the access token belongs.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the access token was created.",
+			},
+			"last_used_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the access token was last used.",
+			},
+		},
+	}
+}
+
+func resourceAccessTokenCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	tokenClient := meta.(gocdclient.AccessTokenClient)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	description := utils.String(d.Get(utils.TerraformResourceDescription))
+
+	response, err := tokenClient.CreateAccessToken(description)
+	if err != nil {
+		return diag.Errorf("creating access token '%s' errored with: %v", description, err)
+	}
+
+	if err = d.Set(utils.TerraformResourceToken, response.Token); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceToken, err)
+	}
+
+	d.SetId(strconv.FormatInt(response.ID, 10))
+
+	return resourceAccessTokenRead(ctx, d, meta)
+}
+
+func resourceAccessTokenRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	tokenClient := meta.(gocdclient.AccessTokenClient)
+
+	response, err := tokenClient.GetAccessToken(d.Id())
+	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("access token '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
+		return diag.Errorf("getting access token '%s' errored with: %v", d.Id(), err)
+	}
+
+	if response.Revoked {
+		log.Printf("access token '%s' was revoked by '%s' with cause '%s', removing it from the state",
+			d.Id(), response.RevokedBy, response.RevokeCause)
+		d.SetId("")
+
+		return nil
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceDescription: response.Description,
+		utils.TerraformResourceUserName:    response.Username,
+		utils.TerraformResourceCreatedAt:   response.CreatedAt,
+		utils.TerraformResourceLastUsedAt:  response.LastUsedAt,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAccessTokenUpdate(_ context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+	// revoke_cause is used only while revoking the token on destroy, hence there is nothing to update in GoCD.
+	log.Printf("nothing to update so skipping")
+
+	return nil
+}
+
+func resourceAccessTokenDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	tokenClient := meta.(gocdclient.AccessTokenClient)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	if _, err := tokenClient.RevokeAccessToken(id, utils.String(d.Get(utils.TerraformResourceRevokeCause))); err != nil {
+		if !gocdclient.IsNotFound(err) {
+			return diag.Errorf("revoking access token '%s' errored with: %v", id, err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -13,6 +16,7 @@ func resourceAgentConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAgentConfigCreate,
 		ReadContext:   resourceAgentConfigRead,
+		UpdateContext: resourceAgentConfigUpdate,
 		DeleteContext: resourceAgentConfigDelete,
 		Schema: map[string]*schema.Schema{
 			"uuid": {
@@ -25,22 +29,23 @@ func resourceAgentConfig() *schema.Resource {
 			"hostname": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Computed:    false,
-				ForceNew:    true,
+				Computed:    true,
+				ForceNew:    false,
 				Description: "The hostname of the agent.",
 			},
 			"agent_config_state": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    false,
-				ForceNew:    true,
-				Description: "Whether an agent is enabled or not. Can be one of `Enabled`, `Disabled`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         false,
+				DiffSuppressFunc: suppressCaseInsensitiveDiff,
+				Description:      "Whether an agent is enabled or not. Can be one of `Enabled`, `Disabled`.",
 			},
 			"resources": {
 				Type:        schema.TypeList,
 				Optional:    true,
 				Computed:    false,
-				ForceNew:    true,
+				ForceNew:    false,
 				Description: "The set of resources that this agent is tagged with (if agent is not an elastic agent).",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
@@ -48,10 +53,24 @@ func resourceAgentConfig() *schema.Resource {
 				Type:        schema.TypeList,
 				Optional:    true,
 				Computed:    false,
-				ForceNew:    true,
+				ForceNew:    false,
 				Description: "The set of environments that this agent belongs to.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"disable_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    false,
+				Description: "Enabling this would disable the agent on destroy, instead of just removing it from the state.",
+			},
+			"delete_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    false,
+				Description: "Enabling this would disable and delete the agent from GoCD on destroy, instead of just removing it from the state.",
+			},
 			"ip_address": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -107,6 +126,13 @@ func resourceAgentConfigRead(_ context.Context, d *schema.ResourceData, meta any
 
 	response, err := defaultConfig.GetAgent(d.Id())
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("agent '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("fetching information of agent '%s' errored with %v", d.Id(), err)
 	}
 
@@ -120,16 +146,111 @@ func resourceAgentConfigRead(_ context.Context, d *schema.ResourceData, meta any
 		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceOperatingSystem, err)
 	}
 
+	if err = d.Set(utils.TerraformResourceHostname, response.Name); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceHostname, err)
+	}
+
+	if err = d.Set(utils.TerraformResourceAgentConfigState, response.ConfigState); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceAgentConfigState, err)
+	}
+
+	if err = d.Set(utils.TerraformResourceResources, response.Resources); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceResources, err)
+	}
+
+	environments := make([]string, 0)
+	if response.Environments != nil {
+		environments = flattenEnvironments(response.Environments)
+	}
+
+	if err = d.Set(utils.TerraformResourceEnvironments, environments); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceEnvironments, err)
+	}
+
 	return nil
 }
 
-func resourceAgentConfigDelete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+func resourceAgentConfigUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.HasChanges(utils.TerraformResourceHostname, utils.TerraformResourceAgentConfigState,
+		utils.TerraformResourceResources, utils.TerraformResourceEnvironments) {
+		log.Printf("nothing to update so skipping")
+
+		return nil
+	}
+
+	cfg := gocd.Agent{
+		ID:           d.Id(),
+		Name:         utils.String(d.Get(utils.TerraformResourceHostname)),
+		Environments: utils.GetSlice(d.Get(utils.TerraformResourceEnvironments).([]any)),
+		Resources:    utils.GetSlice(d.Get(utils.TerraformResourceResources).([]any)),
+		ConfigState:  utils.String(d.Get(utils.TerraformResourceAgentConfigState)),
+	}
+
+	if err := defaultConfig.UpdateAgent(cfg); err != nil {
+		return diag.Errorf("updating agent '%s' errored with %v", d.Id(), err)
+	}
+
+	// empty resources/environments are dropped from the update request, hence the ones removed are cleared with bulk operations.
+	operations := gocd.Operations{
+		Resources:    gocd.AddRemoves{Remove: getClearedAgentAttribute(d, utils.TerraformResourceResources)},
+		Environments: gocd.AddRemoves{Remove: getClearedAgentAttribute(d, utils.TerraformResourceEnvironments)},
+	}
+
+	if len(operations.Resources.Remove) != 0 || len(operations.Environments.Remove) != 0 {
+		if err := defaultConfig.UpdateAgentBulk(gocd.Agent{UUIDS: []string{d.Id()}, Operations: operations}); err != nil {
+			return diag.Errorf("removing resources/environments of agent '%s' errored with %v", d.Id(), err)
+		}
+	}
+
+	return resourceAgentConfigRead(ctx, d, meta)
+}
+
+func resourceAgentConfigDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
 	id := d.Id()
 	if len(d.Id()) == 0 {
 		return diag.Errorf("resource with the ID '%s' not found", id)
 	}
 
+	deleteOnDestroy := utils.Bool(d.Get(utils.TerraformResourceDeleteOnDestroy))
+
+	// GoCD allows deleting only the disabled agents, so agent is disabled before deleting it.
+	if deleteOnDestroy || utils.Bool(d.Get(utils.TerraformResourceDisableOnDestroy)) {
+		if err := defaultConfig.UpdateAgent(gocd.Agent{ID: id, ConfigState: agentConfigStateDisabled}); err != nil {
+			return diag.Errorf("disabling agent '%s' errored with %v", id, err)
+		}
+	}
+
+	if deleteOnDestroy {
+		if _, err := defaultConfig.DeleteAgent(id); err != nil {
+			return diag.Errorf("deleting agent '%s' errored with %v", id, err)
+		}
+	}
+
 	d.SetId("")
 
 	return nil
 }
+
+const agentConfigStateDisabled = "Disabled"
+
+// getClearedAgentAttribute returns the values that were previously set, if the attribute was emptied in the config.
+func getClearedAgentAttribute(d *schema.ResourceData, attribute string) []string {
+	if !d.HasChange(attribute) {
+		return nil
+	}
+
+	oldValues, newValues := d.GetChange(attribute)
+	if len(newValues.([]any)) != 0 {
+		return nil
+	}
+
+	return utils.GetSlice(oldValues.([]any))
+}
+
+func suppressCaseInsensitiveDiff(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(oldValue, newValue)
+}
@@ -0,0 +1,496 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func resourceAgents() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAgentsCreate,
+		ReadContext:   resourceAgentsRead,
+		UpdateContext: resourceAgentsUpdate,
+		DeleteContext: resourceAgentsDelete,
+		CustomizeDiff: resourceAgentsCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"uuids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     false,
+				ExactlyOneOf: []string{utils.TerraformResourceUUIDs, utils.TerraformResourceFilter},
+				Description:  "The identifiers of the agents to be managed.",
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"filter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    false,
+				MaxItems:    1,
+				Description: "Filter to select the agents to be managed, agents matching all the criteria set would be selected.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname_regex": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Regular expression to match the hostname of the agents.",
+						},
+						"operating_system": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The operating system as reported by the agents (case-insensitive).",
+						},
+						"resource": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The resource that the agents are already tagged with.",
+						},
+					},
+				},
+			},
+			"resources": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    false,
+				Description: "The set of resources to be added to the selected agents.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"environments": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    false,
+				Description: "The set of environments to which the selected agents to be added.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"agent_config_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         false,
+				DiffSuppressFunc: suppressCaseInsensitiveDiff,
+				Description:      "Whether the selected agents should be enabled or not. Can be one of `Enabled`, `Disabled`.",
+			},
+			"matched_agents": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of agents selected and configured by this resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the agent.",
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hostname of the agent.",
+						},
+						"operating_system": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The operating system as reported by the agent.",
+						},
+						"configured": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the agent still carries the resources, environments and config state applied, it is reconfigured on next apply otherwise.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAgentsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	id, err := utils.GetRandomID()
+	if err != nil {
+		d.SetId("")
+
+		return diag.Errorf("errored while fetching randomID %v", err)
+	}
+
+	agents, err := defaultConfig.GetAgents()
+	if err != nil {
+		return diag.Errorf("getting agents errored with: %v", err)
+	}
+
+	matchedAgents, err := getSelectedAgents(agents, d.Get(utils.TerraformResourceUUIDs), d.Get(utils.TerraformResourceFilter))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(matchedAgents) != 0 {
+		cfg := gocd.Agent{
+			UUIDS:       getAgentIDs(matchedAgents),
+			ConfigState: utils.String(d.Get(utils.TerraformResourceAgentConfigState)),
+			Operations: gocd.Operations{
+				Resources:    gocd.AddRemoves{Add: utils.GetSlice(d.Get(utils.TerraformResourceResources).(*schema.Set).List())},
+				Environments: gocd.AddRemoves{Add: utils.GetSlice(d.Get(utils.TerraformResourceEnvironments).(*schema.Set).List())},
+			},
+		}
+
+		if err = defaultConfig.UpdateAgentBulk(cfg); err != nil {
+			return diag.Errorf("bulk updating agents %v errored with: %v", cfg.UUIDS, err)
+		}
+	} else {
+		log.Printf("no agents matched the filter, agents matching it later would be configured on subsequent apply")
+	}
+
+	if err = d.Set(utils.TerraformResourceMatchedAgents, flattenMatchedAgents(matchedAgents)); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceMatchedAgents, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAgentsRead(ctx, d, meta)
+}
+
+func resourceAgentsRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	agents, err := defaultConfig.GetAgents()
+	if err != nil {
+		return diag.Errorf("getting agents errored with: %v", err)
+	}
+
+	resources := utils.GetSlice(d.Get(utils.TerraformResourceResources).(*schema.Set).List())
+	environments := utils.GetSlice(d.Get(utils.TerraformResourceEnvironments).(*schema.Set).List())
+	configState := utils.String(d.Get(utils.TerraformResourceAgentConfigState))
+
+	// agents which were deleted are dropped, whereas the ones that no more carry the configuration applied are retained
+	// so that they are either reconfigured or released on next apply.
+	matchedIDs := getMatchedAgentIDs(d.Get(utils.TerraformResourceMatchedAgents))
+	matchedAgents := make([]map[string]any, 0, len(matchedIDs))
+
+	for _, agent := range agents {
+		if !slices.Contains(matchedIDs, agent.ID) {
+			continue
+		}
+
+		configured := agentConfigured(agent, resources, environments, configState)
+		if !configured {
+			log.Printf("agent '%s' is not configured as expected, it would be reconfigured", agent.ID)
+		}
+
+		matchedAgents = append(matchedAgents, flattenMatchedAgent(agent, configured))
+	}
+
+	if err = d.Set(utils.TerraformResourceMatchedAgents, matchedAgents); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceMatchedAgents, err)
+	}
+
+	return nil
+}
+
+func resourceAgentsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	agents, err := defaultConfig.GetAgents()
+	if err != nil {
+		return diag.Errorf("getting agents errored with: %v", err)
+	}
+
+	matchedAgents, err := getSelectedAgents(agents, d.Get(utils.TerraformResourceUUIDs), d.Get(utils.TerraformResourceFilter))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	matchedIDs := getAgentIDs(matchedAgents)
+
+	oldResources, newResources := d.GetChange(utils.TerraformResourceResources)
+	oldEnvironments, newEnvironments := d.GetChange(utils.TerraformResourceEnvironments)
+
+	removedResources := utils.GetSlice(oldResources.(*schema.Set).Difference(newResources.(*schema.Set)).List())
+	removedEnvironments := utils.GetSlice(oldEnvironments.(*schema.Set).Difference(newEnvironments.(*schema.Set)).List())
+
+	// agents which are no more selected would have the resources and environments added by this resource removed.
+	oldMatchedIDs, _ := d.GetChange(utils.TerraformResourceMatchedAgents)
+
+	releasedIDs := make([]string, 0)
+
+	for _, agentID := range getMatchedAgentIDs(oldMatchedIDs) {
+		if !slices.Contains(matchedIDs, agentID) && agentExists(agents, agentID) {
+			releasedIDs = append(releasedIDs, agentID)
+		}
+	}
+
+	if len(releasedIDs) != 0 {
+		if err = releaseAgents(defaultConfig, releasedIDs, oldResources.(*schema.Set), oldEnvironments.(*schema.Set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if len(matchedIDs) != 0 {
+		cfg := gocd.Agent{
+			UUIDS:       matchedIDs,
+			ConfigState: utils.String(d.Get(utils.TerraformResourceAgentConfigState)),
+			Operations: gocd.Operations{
+				Resources: gocd.AddRemoves{
+					Add:    utils.GetSlice(newResources.(*schema.Set).List()),
+					Remove: removedResources,
+				},
+				Environments: gocd.AddRemoves{
+					Add:    utils.GetSlice(newEnvironments.(*schema.Set).List()),
+					Remove: removedEnvironments,
+				},
+			},
+		}
+
+		if err = defaultConfig.UpdateAgentBulk(cfg); err != nil {
+			return diag.Errorf("bulk updating agents %v errored with: %v", cfg.UUIDS, err)
+		}
+	}
+
+	if err = d.Set(utils.TerraformResourceMatchedAgents, flattenMatchedAgents(matchedAgents)); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceMatchedAgents, err)
+	}
+
+	return resourceAgentsRead(ctx, d, meta)
+}
+
+func resourceAgentsDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	agents, err := defaultConfig.GetAgents()
+	if err != nil {
+		return diag.Errorf("getting agents errored with: %v", err)
+	}
+
+	agentIDs := make([]string, 0)
+
+	for _, agentID := range getMatchedAgentIDs(d.Get(utils.TerraformResourceMatchedAgents)) {
+		if agentExists(agents, agentID) {
+			agentIDs = append(agentIDs, agentID)
+		}
+	}
+
+	if len(agentIDs) != 0 {
+		if err = releaseAgents(defaultConfig, agentIDs, d.Get(utils.TerraformResourceResources).(*schema.Set),
+			d.Get(utils.TerraformResourceEnvironments).(*schema.Set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceAgentsCustomizeDiff plans an update whenever the agents selected differ from the ones configured earlier,
+// which happens when agents matching the filter come up, or whenever the agents configured drift.
+func resourceAgentsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if len(d.Id()) == 0 {
+		return nil
+	}
+
+	defaultConfig := meta.(gocd.GoCd)
+
+	agents, err := defaultConfig.GetAgents()
+	if err != nil {
+		return fmt.Errorf("getting agents errored with: %w", err)
+	}
+
+	selectedAgents, err := getSelectedAgents(agents, d.Get(utils.TerraformResourceUUIDs), d.Get(utils.TerraformResourceFilter))
+	if err != nil {
+		return err
+	}
+
+	selectedIDs := getAgentIDs(selectedAgents)
+	matchedIDs := getMatchedAgentIDs(d.Get(utils.TerraformResourceMatchedAgents))
+
+	slices.Sort(selectedIDs)
+	slices.Sort(matchedIDs)
+
+	if !slices.Equal(selectedIDs, matchedIDs) || !matchedAgentsConfigured(d.Get(utils.TerraformResourceMatchedAgents)) ||
+		d.HasChanges(utils.TerraformResourceResources, utils.TerraformResourceEnvironments, utils.TerraformResourceAgentConfigState) {
+		return d.SetNewComputed(utils.TerraformResourceMatchedAgents)
+	}
+
+	return nil
+}
+
+func releaseAgents(defaultConfig gocd.GoCd, agentIDs []string, resources, environments *schema.Set) error {
+	if resources.Len() == 0 && environments.Len() == 0 {
+		return nil
+	}
+
+	cfg := gocd.Agent{
+		UUIDS: agentIDs,
+		Operations: gocd.Operations{
+			Resources:    gocd.AddRemoves{Remove: utils.GetSlice(resources.List())},
+			Environments: gocd.AddRemoves{Remove: utils.GetSlice(environments.List())},
+		},
+	}
+
+	if err := defaultConfig.UpdateAgentBulk(cfg); err != nil {
+		return fmt.Errorf("removing resources/environments from agents %v errored with: %w", agentIDs, err)
+	}
+
+	return nil
+}
+
+// getSelectedAgents returns the agents selected either by the uuids or by the filter.
+func getSelectedAgents(agents []gocd.Agent, uuids any, filters any) ([]gocd.Agent, error) {
+	agentIDs := utils.GetSlice(uuids.(*schema.Set).List())
+	selectedAgents := make([]gocd.Agent, 0)
+
+	if len(agentIDs) != 0 {
+		for _, agentID := range agentIDs {
+			if !agentExists(agents, agentID) {
+				return nil, fmt.Errorf("agent '%s' not found in GoCD", agentID)
+			}
+		}
+
+		for _, agent := range agents {
+			if slices.Contains(agentIDs, agent.ID) {
+				selectedAgents = append(selectedAgents, agent)
+			}
+		}
+
+		return selectedAgents, nil
+	}
+
+	filterList, _ := filters.([]any)
+	if len(filterList) == 0 || filterList[0] == nil {
+		return selectedAgents, nil
+	}
+
+	filter := filterList[0].(map[string]any)
+
+	var hostnameRegex *regexp.Regexp
+
+	if pattern := utils.String(filter[utils.TerraformResourceHostnameRegex]); len(pattern) != 0 {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("compiling hostname_regex '%s' errored with: %w", pattern, err)
+		}
+
+		hostnameRegex = regex
+	}
+
+	operatingSystem := utils.String(filter[utils.TerraformResourceOperatingSystem])
+	resource := utils.String(filter["resource"])
+
+	for _, agent := range agents {
+		if hostnameRegex != nil && !hostnameRegex.MatchString(agent.Name) {
+			continue
+		}
+
+		if len(operatingSystem) != 0 && !strings.EqualFold(agent.OS, operatingSystem) {
+			continue
+		}
+
+		if len(resource) != 0 && !slices.Contains(agent.Resources, resource) {
+			continue
+		}
+
+		selectedAgents = append(selectedAgents, agent)
+	}
+
+	return selectedAgents, nil
+}
+
+func agentConfigured(agent gocd.Agent, resources, environments []string, configState string) bool {
+	if len(configState) != 0 && !strings.EqualFold(agent.ConfigState, configState) {
+		return false
+	}
+
+	for _, resource := range resources {
+		if !slices.Contains(agent.Resources, resource) {
+			return false
+		}
+	}
+
+	var agentEnvironments []string
+	if agent.Environments != nil {
+		agentEnvironments = flattenEnvironments(agent.Environments)
+	}
+
+	for _, environment := range environments {
+		if !slices.Contains(agentEnvironments, environment) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func agentExists(agents []gocd.Agent, agentID string) bool {
+	return slices.ContainsFunc(agents, func(agent gocd.Agent) bool {
+		return agent.ID == agentID
+	})
+}
+
+func getAgentIDs(agents []gocd.Agent) []string {
+	agentIDs := make([]string, 0, len(agents))
+	for _, agent := range agents {
+		agentIDs = append(agentIDs, agent.ID)
+	}
+
+	return agentIDs
+}
+
+func getMatchedAgentIDs(matchedAgents any) []string {
+	agents, _ := matchedAgents.([]any)
+	agentIDs := make([]string, 0, len(agents))
+
+	for _, agent := range agents {
+		if agentMap, ok := agent.(map[string]any); ok {
+			agentIDs = append(agentIDs, utils.String(agentMap[utils.TerraformResourceUUID]))
+		}
+	}
+
+	return agentIDs
+}
+
+// matchedAgentsConfigured reports whether all the agents matched still carry the configuration applied.
+func matchedAgentsConfigured(matchedAgents any) bool {
+	agents, _ := matchedAgents.([]any)
+
+	for _, agent := range agents {
+		if agentMap, ok := agent.(map[string]any); ok && !utils.Bool(agentMap[utils.TerraformResourceConfigured]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenMatchedAgents(agents []gocd.Agent) []map[string]any {
+	flattenedAgents := make([]map[string]any, 0, len(agents))
+
+	for _, agent := range agents {
+		flattenedAgents = append(flattenedAgents, flattenMatchedAgent(agent, true))
+	}
+
+	return flattenedAgents
+}
+
+func flattenMatchedAgent(agent gocd.Agent, configured bool) map[string]any {
+	return map[string]any{
+		utils.TerraformResourceUUID:            agent.ID,
+		utils.TerraformResourceHostname:        agent.Name,
+		utils.TerraformResourceOperatingSystem: agent.OS,
+		utils.TerraformResourceConfigured:      configured,
+	}
+}
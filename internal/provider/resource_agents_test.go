@@ -0,0 +1,110 @@
+//nolint:testpackage
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+// agentsClient serves the agents registered with GoCD.
+type agentsClient struct {
+	gocd.GoCd
+	agents []gocd.Agent
+}
+
+func (client agentsClient) GetAgents() ([]gocd.Agent, error) {
+	return client.agents, nil
+}
+
+func TestGetSelectedAgents(t *testing.T) {
+	agents := []gocd.Agent{
+		{ID: "agent-1", Name: "sample.agent001.com", OS: "Linux", Resources: []string{"docker"}},
+		{ID: "agent-2", Name: "sample.agent002.com", OS: "Mac OS X", Resources: []string{"docker"}},
+		{ID: "agent-3", Name: "build.agent003.com", OS: "Linux"},
+	}
+
+	tests := map[string]struct {
+		uuids    []any
+		filter   []any
+		expected []string
+		wantErr  bool
+	}{
+		"select by uuids": {
+			uuids:    []any{"agent-3", "agent-1"},
+			expected: []string{"agent-1", "agent-3"},
+		},
+		"unknown uuid": {
+			uuids:   []any{"agent-4"},
+			wantErr: true,
+		},
+		"filter by hostname and operating system": {
+			filter:   []any{map[string]any{"hostname_regex": "^sample", "operating_system": "linux", "resource": ""}},
+			expected: []string{"agent-1"},
+		},
+		"filter by resource": {
+			filter:   []any{map[string]any{"hostname_regex": "", "operating_system": "", "resource": "docker"}},
+			expected: []string{"agent-1", "agent-2"},
+		},
+		"invalid hostname regex": {
+			filter:  []any{map[string]any{"hostname_regex": "[", "operating_system": "", "resource": ""}},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			selected, err := getSelectedAgents(agents, schema.NewSet(schema.HashString, tt.uuids), tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error to be %t, got: %v", tt.wantErr, err)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got := getAgentIDs(selected); !reflect.DeepEqual(got, tt.expected) {
+				t.Fatalf("expected agents %v to be selected, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestResourceAgentsRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAgents().Schema, map[string]any{
+		"uuids":     []any{"agent-1", "agent-2", "agent-3"},
+		"resources": []any{"docker"},
+	})
+	d.SetId("agents")
+
+	client := agentsClient{agents: []gocd.Agent{
+		{ID: "agent-1", Name: "sample.agent001.com", OS: "Linux", Resources: []string{"docker"}},
+		// resources of the agent were changed outside terraform.
+		{ID: "agent-2", Name: "sample.agent002.com", OS: "Linux", Resources: []string{"java"}},
+	}}
+
+	matchedAgents := flattenMatchedAgents([]gocd.Agent{{ID: "agent-1"}, {ID: "agent-2"}, {ID: "agent-3"}})
+	if err := d.Set("matched_agents", matchedAgents); err != nil {
+		t.Fatalf("setting matched_agents errored with: %v", err)
+	}
+
+	if diags := resourceAgentsRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("expected agents to be read, got: %v", diags)
+	}
+
+	expected := []string{"agent-1", "agent-2"}
+	if actual := getMatchedAgentIDs(d.Get("matched_agents")); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected the agents deleted to be dropped and the ones drifted to be retained %v, got %v", expected, actual)
+	}
+
+	if d.Get("matched_agents.0.configured") != true || d.Get("matched_agents.1.configured") != false {
+		t.Errorf("expected only the agent drifted to be reported as not configured, got: %v", d.Get("matched_agents"))
+	}
+
+	if matchedAgentsConfigured(d.Get("matched_agents")) {
+		t.Errorf("expected the drift of the matched agents to be reported")
+	}
+}
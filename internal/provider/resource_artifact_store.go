@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -83,6 +84,13 @@ func resourceArtifactStoreRead(_ context.Context, d *schema.ResourceData, meta a
 
 	response, err := defaultConfig.GetArtifactStore(storeID)
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("artifact store '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("getting artifact store configuration '%s' errored with: %v", storeID, err)
 	}
 
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const (
+	artifactsConfigID   = "artifacts_config"
+	defaultArtifactsDir = "artifacts"
+)
+
+func resourceArtifactsConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceArtifactsConfigCreate,
+		ReadContext:   resourceArtifactsConfigRead,
+		UpdateContext: resourceArtifactsConfigUpdate,
+		DeleteContext: resourceArtifactsConfigDelete,
+		Schema: map[string]*schema.Schema{
+			"artifacts_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Default:     defaultArtifactsDir,
+				Description: "The directory where GoCD stores its artifacts, relative paths are resolved against the server installation directory.",
+			},
+			"purge_start_disk_space": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     false,
+				RequiredWith: []string{utils.TerraformResourcePurgeUptoDiskSpace},
+				Description:  "The available disk space in GB below which GoCD starts purging the old artifacts, artifacts are never purged when not set.",
+			},
+			"purge_upto_disk_space": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     false,
+				RequiredWith: []string{utils.TerraformResourcePurgeStartDiskSpace},
+				Description:  "The available disk space in GB up to which GoCD purges the old artifacts once purging starts.",
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Required:    false,
+				Computed:    true,
+				ForceNew:    false,
+				Description: "Etag used to track the artifacts configuration.",
+			},
+		},
+	}
+}
+
+func resourceArtifactsConfigCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	// artifacts configuration always exists in GoCD, the latest etag is required to update it.
+	response, err := defaultConfig.GetArtifactConfig()
+	if err != nil {
+		return diag.Errorf("getting artifacts configuration errored with: %v", err)
+	}
+
+	cfg := getArtifactsConfig(d)
+	cfg.ETAG = response.ETAG
+
+	if _, err = defaultConfig.UpdateArtifactConfig(cfg); err != nil {
+		return diag.Errorf("creating artifacts configuration errored with %v", err)
+	}
+
+	d.SetId(artifactsConfigID)
+
+	return resourceArtifactsConfigRead(ctx, d, meta)
+}
+
+func resourceArtifactsConfigRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	response, err := defaultConfig.GetArtifactConfig()
+	if err != nil {
+		return diag.Errorf("getting artifacts configuration errored with: %v", err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceArtifactsDir:        response.ArtifactsDir,
+		utils.TerraformResourcePurgeStartDiskSpace: response.PurgeSettings.PurgeStartDiskSpace,
+		utils.TerraformResourcePurgeUptoDiskSpace:  response.PurgeSettings.PurgeUptoDiskSpace,
+		utils.TerraformResourceEtag:                response.ETAG,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArtifactsConfigUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.HasChanges(
+		utils.TerraformResourceArtifactsDir,
+		utils.TerraformResourcePurgeStartDiskSpace,
+		utils.TerraformResourcePurgeUptoDiskSpace,
+	) {
+		log.Printf("nothing to update so skipping")
+
+		return nil
+	}
+
+	cfg := getArtifactsConfig(d)
+	cfg.ETAG = utils.String(d.Get(utils.TerraformResourceEtag))
+
+	if _, err := defaultConfig.UpdateArtifactConfig(cfg); err != nil {
+		return diag.Errorf("updating artifacts configuration errored with %v", err)
+	}
+
+	return resourceArtifactsConfigRead(ctx, d, meta)
+}
+
+func resourceArtifactsConfigDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	response, err := defaultConfig.GetArtifactConfig()
+	if err != nil {
+		return diag.Errorf("getting artifacts configuration errored with: %v", err)
+	}
+
+	// artifacts configuration cannot be deleted from GoCD, hence it is reset to the defaults.
+	cfg := gocd.ArtifactInfo{
+		ArtifactsDir: defaultArtifactsDir,
+		ETAG:         response.ETAG,
+	}
+
+	if _, err = defaultConfig.UpdateArtifactConfig(cfg); err != nil {
+		return diag.Errorf("resetting artifacts configuration errored with: %v", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getArtifactsConfig(d *schema.ResourceData) gocd.ArtifactInfo {
+	return gocd.ArtifactInfo{
+		ArtifactsDir: utils.String(d.Get(utils.TerraformResourceArtifactsDir)),
+		PurgeSettings: gocd.PurgeSettings{
+			PurgeStartDiskSpace: d.Get(utils.TerraformResourcePurgeStartDiskSpace).(float64),
+			PurgeUptoDiskSpace:  d.Get(utils.TerraformResourcePurgeUptoDiskSpace).(float64),
+		},
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -107,6 +108,13 @@ func resourceAuthConfigRead(_ context.Context, d *schema.ResourceData, meta any)
 
 	response, err := defaultConfig.GetAuthConfig(profileID)
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("auth config '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("getting auth configuration %s errored with: %v", profileID, err)
 	}
 
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -69,6 +70,10 @@ func resourceClusterProfileCreate(ctx context.Context, d *schema.ResourceData, m
 		return nil
 	}
 
+	if diags := requireServerVersion(meta, "cluster profiles", gocdclient.MinClusterProfileVersion); diags != nil {
+		return diags
+	}
+
 	id := d.Id()
 
 	if len(id) == 0 {
@@ -99,6 +104,13 @@ func resourceClusterProfileRead(_ context.Context, d *schema.ResourceData, meta
 
 	response, err := defaultConfig.GetClusterProfile(profileID)
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("cluster profile '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("getting cluster profile configuration %s errored with: %v", profileID, err)
 	}
 
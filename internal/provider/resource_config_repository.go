@@ -5,14 +5,18 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/mapstructure"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
+const defaultConfigRepoParseTimeout = 600
+
 func resourceConfigRepository() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceConfigRepoCreate,
@@ -63,6 +67,35 @@ func resourceConfigRepository() *schema.Resource {
 				ForceNew:    false,
 				Description: "Etag used to track the config repository.",
 			},
+			"wait_for_parse": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Default:     false,
+				Description: "Enable to wait until GoCD parses the config repository, the apply fails with the parse errors if it could not be parsed.",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    false,
+				Default:     defaultConfigRepoParseTimeout,
+				Description: "Time to wait for GoCD to parse the config repository (in seconds ex: 600).",
+			},
+			"last_parsed_revision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The latest revision of the config repository that GoCD attempted to parse.",
+			},
+			"last_parse_success": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the latest revision of the config repository was parsed successfully.",
+			},
+			"parse_error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The error reported by the config repo plugin while parsing the latest revision.",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceConfigRepoImport,
@@ -108,6 +141,10 @@ func resourceConfigRepoCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(id)
 
+	if diags := waitForConfigRepoParse(ctx, d, defaultConfig, cfg.ID, gocd.ConfigRepoParseInfo{}); diags != nil {
+		return diags
+	}
+
 	return dataSourceConfigRepositoryRead(ctx, d, meta)
 }
 
@@ -118,6 +155,13 @@ func resourceConfigRepoRead(_ context.Context, d *schema.ResourceData, meta any)
 
 	response, err := defaultConfig.GetConfigRepo(profileID)
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("config repo '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("getting config repo %s errored with: %v", profileID, err)
 	}
 
@@ -148,16 +192,25 @@ func resourceConfigRepoRead(_ context.Context, d *schema.ResourceData, meta any)
 		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceEtag, err)
 	}
 
-	return nil
+	// parse information is available only with the internal API, failing to fetch it should not fail reading the config repo.
+	parseInfo, err := getConfigRepoParseInfo(defaultConfig, profileID)
+	if err != nil {
+		log.Printf("getting parse information of config repo '%s' errored with: %v, skipping it", profileID, err)
+
+		return nil
+	}
+
+	return setConfigRepoParseInfo(d, parseInfo)
 }
 
 func resourceConfigRepoUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	defaultConfig := meta.(gocd.GoCd)
 
-	if !d.HasChange(utils.TerraformResourceMaterial) &&
-		!d.HasChange(utils.TerraformResourceRules) &&
-		!d.HasChange(utils.TerraformResourceConfiguration) {
-		return nil
+	// wait_for_parse and timeout are used only by terraform, changing them alone should not update the config repo in GoCD.
+	if !d.HasChangesExcept(utils.TerraformResourceWaitForParse, utils.TerraformResourceTimeout) {
+		log.Printf("nothing to update so skipping")
+
+		return resourceConfigRepoRead(ctx, d, meta)
 	}
 
 	rules, err := flattenMapSlice(d.Get(utils.TerraformResourceRules))
@@ -183,6 +236,24 @@ func resourceConfigRepoUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("updating config repo %s errored with: %v", cfg.ID, err)
 	}
 
+	var previousParseInfo gocd.ConfigRepoParseInfo
+
+	if utils.Bool(d.Get(utils.TerraformResourceWaitForParse)) {
+		// parse information of the previous revision is reported until GoCD picks up the update,
+		// it is recorded before triggering so that the wait does not pass on it.
+		if previousParseInfo, err = getConfigRepoParseInfo(defaultConfig, cfg.ID); err != nil {
+			return diag.Errorf("getting parse information of config repo %s errored with: %v", cfg.ID, err)
+		}
+
+		if _, err = defaultConfig.ConfigRepoTriggerUpdate(cfg.ID); err != nil {
+			return diag.Errorf("triggering update of config repo %s errored with: %v", cfg.ID, err)
+		}
+	}
+
+	if diags := waitForConfigRepoParse(ctx, d, defaultConfig, cfg.ID, previousParseInfo); diags != nil {
+		return diags
+	}
+
 	return dataSourceConfigRepositoryRead(ctx, d, meta)
 }
 
@@ -249,6 +320,107 @@ func resourceConfigRepoImport(_ context.Context, d *schema.ResourceData, meta an
 	return []*schema.ResourceData{d}, nil
 }
 
+// waitForConfigRepoParse waits until GoCD is done parsing the config repo when wait_for_parse is enabled,
+// and fails if the latest revision of it could not be parsed. The parse information reported before the wait
+// is passed as previous, so that it is not mistaken for the result of the parse being waited on.
+func waitForConfigRepoParse(ctx context.Context, d *schema.ResourceData, defaultConfig gocd.GoCd, profileID string,
+	previous gocd.ConfigRepoParseInfo,
+) diag.Diagnostics {
+	if !utils.Bool(d.Get(utils.TerraformResourceWaitForParse)) {
+		return nil
+	}
+
+	timeout := time.Duration(d.Get(utils.TerraformResourceTimeout).(int)) * time.Second
+
+	var (
+		parseInfo      gocd.ConfigRepoParseInfo
+		inProgressSeen bool
+	)
+
+	err := waitUntil(ctx, timeout, time.Duration(defaultDelay)*time.Second, func() (bool, error) {
+		status, err := defaultConfig.ConfigRepoStatus(profileID)
+		if err != nil {
+			return false, err
+		}
+
+		if status["in_progress"] {
+			log.Printf("config repo '%s' is still being parsed", profileID)
+
+			inProgressSeen = true
+
+			return false, nil
+		}
+
+		if parseInfo, err = getConfigRepoParseInfo(defaultConfig, profileID); err != nil {
+			return false, err
+		}
+
+		return configRepoParsed(previous, parseInfo, inProgressSeen), nil
+	})
+	if err != nil {
+		return diag.Errorf("waiting for config repo %s to be parsed errored with: %v", profileID, err)
+	}
+
+	if diags := setConfigRepoParseInfo(d, parseInfo); diags != nil {
+		return diags
+	}
+
+	if len(parseInfo.Error) != 0 {
+		return diag.Errorf("config repo %s could not be parsed: %s", profileID, parseInfo.Error)
+	}
+
+	return nil
+}
+
+// configRepoParsed reports whether the parse information is of a parse completed after the previous one,
+// which is when a parse was seen in progress or the parsed revision or error differs from the previous.
+func configRepoParsed(previous, current gocd.ConfigRepoParseInfo, inProgressSeen bool) bool {
+	previousRevision, _ := previous.LatestParsedModification["revision"].(string)
+	currentRevision, _ := current.LatestParsedModification["revision"].(string)
+
+	// GoCD would not have picked up the config repo yet when neither a revision is parsed nor an error is reported.
+	if len(currentRevision) == 0 && len(current.Error) == 0 {
+		return false
+	}
+
+	return inProgressSeen || currentRevision != previousRevision || current.Error != previous.Error
+}
+
+// getConfigRepoParseInfo fetches the parse information of the config repo, which is available only with the internal API.
+func getConfigRepoParseInfo(defaultConfig gocd.GoCd, profileID string) (gocd.ConfigRepoParseInfo, error) {
+	configRepos, err := defaultConfig.GetConfigReposInternal()
+	if err != nil {
+		return gocd.ConfigRepoParseInfo{}, err
+	}
+
+	for _, configRepo := range configRepos {
+		if configRepo.ID == profileID {
+			return configRepo.ConfigRepoParseInfo, nil
+		}
+	}
+
+	return gocd.ConfigRepoParseInfo{}, nil
+}
+
+func setConfigRepoParseInfo(d *schema.ResourceData, parseInfo gocd.ConfigRepoParseInfo) diag.Diagnostics {
+	latestRevision, _ := parseInfo.LatestParsedModification["revision"].(string)
+	goodRevision, _ := parseInfo.GoodModification["revision"].(string)
+
+	attributes := map[string]any{
+		utils.TerraformResourceLastParsedRevision: latestRevision,
+		utils.TerraformResourceLastParseSuccess:   len(parseInfo.Error) == 0 && len(latestRevision) != 0 && latestRevision == goodRevision,
+		utils.TerraformResourceParseError:         parseInfo.Error,
+	}
+
+	for attribute, value := range attributes {
+		if err := d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return nil
+}
+
 func flattenMapSlice(configs any) ([]map[string]string, error) {
 	var rules []map[string]string
 	if err := mapstructure.Decode(configs, &rules); err != nil {
@@ -0,0 +1,104 @@
+//nolint:testpackage
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+// configRepoReadClient serves the config repo from GoCD while the internal API fails.
+type configRepoReadClient struct {
+	gocd.GoCd
+	configRepo gocd.ConfigRepo
+}
+
+func (client configRepoReadClient) GetConfigRepo(_ string) (gocd.ConfigRepo, error) {
+	return client.configRepo, nil
+}
+
+func (client configRepoReadClient) GetConfigReposInternal() ([]gocd.ConfigRepo, error) {
+	return nil, errors.New("forbidden")
+}
+
+func TestConfigRepoParsed(t *testing.T) {
+	parsedAt := func(revision, parseError string) gocd.ConfigRepoParseInfo {
+		return gocd.ConfigRepoParseInfo{LatestParsedModification: map[string]any{"revision": revision}, Error: parseError}
+	}
+
+	tests := []struct {
+		name           string
+		previous       gocd.ConfigRepoParseInfo
+		current        gocd.ConfigRepoParseInfo
+		inProgressSeen bool
+		expected       bool
+	}{
+		{
+			name:     "config repo yet to be picked up",
+			current:  gocd.ConfigRepoParseInfo{},
+			expected: false,
+		},
+		{
+			name:     "first parse of a new config repo",
+			current:  parsedAt("a1b2c3", ""),
+			expected: true,
+		},
+		{
+			name:     "previous revision still reported after the trigger",
+			previous: parsedAt("a1b2c3", ""),
+			current:  parsedAt("a1b2c3", ""),
+			expected: false,
+		},
+		{
+			name:     "new revision parsed with errors",
+			previous: parsedAt("a1b2c3", ""),
+			current:  parsedAt("d4e5f6", "invalid pipeline definition"),
+			expected: true,
+		},
+		{
+			name:           "same revision parsed again after being seen in progress",
+			previous:       parsedAt("a1b2c3", ""),
+			current:        parsedAt("a1b2c3", ""),
+			inProgressSeen: true,
+			expected:       true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := configRepoParsed(test.previous, test.current, test.inProgressSeen); actual != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestResourceConfigRepoReadWithoutParseInfo(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceConfigRepository().Schema, map[string]any{
+		"profile_id": "sample-config-repo",
+		"plugin_id":  "yaml.config.plugin",
+	})
+	d.SetId("sample-config-repo")
+
+	client := configRepoReadClient{configRepo: gocd.ConfigRepo{
+		ID:       "sample-config-repo",
+		PluginID: "yaml.config.plugin",
+		Material: gocd.Material{Type: "git", Attributes: gocd.Attribute{URL: "https://github.com/config-repo/gocd-json-config-example.git"}},
+		ETAG:     "etag",
+	}}
+
+	if diags := resourceConfigRepoRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("expected reading the config repo to tolerate the failure of the internal API, got: %v", diags)
+	}
+
+	if d.Id() != "sample-config-repo" {
+		t.Fatalf("expected the config repo to be retained in the state, got ID '%s'", d.Id())
+	}
+
+	if etag := d.Get("etag"); etag != "etag" {
+		t.Errorf("expected etag to be read from GoCD, got '%v'", etag)
+	}
+}
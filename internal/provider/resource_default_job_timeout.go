@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const (
+	defaultJobTimeoutID  = "default_job_timeout"
+	defaultJobTimeoutKey = "default_job_timeout"
+)
+
+func resourceDefaultJobTimeout() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDefaultJobTimeoutCreate,
+		ReadContext:   resourceDefaultJobTimeoutRead,
+		UpdateContext: resourceDefaultJobTimeoutUpdate,
+		DeleteContext: resourceDefaultJobTimeoutDelete,
+		Schema: map[string]*schema.Schema{
+			"timeout": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Computed:    false,
+				Description: "The time in minutes after which GoCD cancels the jobs that are hung, set 0 to never cancel the jobs.",
+			},
+		},
+	}
+}
+
+func resourceDefaultJobTimeoutCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	if err := defaultConfig.UpdateDefaultJobTimeout(d.Get(utils.TerraformResourceTimeout).(int)); err != nil {
+		return diag.Errorf("creating default job timeout errored with %v", err)
+	}
+
+	d.SetId(defaultJobTimeoutID)
+
+	return resourceDefaultJobTimeoutRead(ctx, d, meta)
+}
+
+func resourceDefaultJobTimeoutRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	response, err := defaultConfig.GetDefaultJobTimeout()
+	if err != nil {
+		return diag.Errorf("getting default job timeout errored with: %v", err)
+	}
+
+	timeout, err := strconv.Atoi(response[defaultJobTimeoutKey])
+	if err != nil {
+		return diag.Errorf("parsing default job timeout '%s' errored with: %v", response[defaultJobTimeoutKey], err)
+	}
+
+	if err = d.Set(utils.TerraformResourceTimeout, timeout); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceTimeout, err)
+	}
+
+	return nil
+}
+
+func resourceDefaultJobTimeoutUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.HasChange(utils.TerraformResourceTimeout) {
+		log.Printf("nothing to update so skipping")
+
+		return nil
+	}
+
+	if err := defaultConfig.UpdateDefaultJobTimeout(d.Get(utils.TerraformResourceTimeout).(int)); err != nil {
+		return diag.Errorf("updating default job timeout errored with %v", err)
+	}
+
+	return resourceDefaultJobTimeoutRead(ctx, d, meta)
+}
+
+func resourceDefaultJobTimeoutDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	// resetting the timeout to 0 is the GoCD default, where the hung jobs are never cancelled.
+	if err := defaultConfig.UpdateDefaultJobTimeout(0); err != nil {
+		return diag.Errorf("resetting default job timeout errored with: %v", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -84,6 +85,13 @@ func resourceElasticAgentProfileRead(_ context.Context, d *schema.ResourceData,
 
 	response, err := defaultConfig.GetElasticAgentProfile(profileID)
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("elastic agent profile '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("getting elastic agent profile configuration %s errored with: %v", profileID, err)
 	}
 
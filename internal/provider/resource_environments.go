@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/mapstructure"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -96,6 +97,13 @@ func resourceEnvironmentRead(_ context.Context, d *schema.ResourceData, meta any
 
 	response, err := defaultConfig.GetEnvironment(envName)
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("environment '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("getting environment %s errored with: %v", envName, err)
 	}
 
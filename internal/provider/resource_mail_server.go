@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const mailServerID = "mail_server"
+
+func resourceMailServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMailServerCreate,
+		ReadContext:   resourceMailServerRead,
+		UpdateContext: resourceMailServerUpdate,
+		DeleteContext: resourceMailServerDelete,
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The hostname of the mail server.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Computed:    false,
+				Description: "The port of the mail server.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "The username used to authenticate with the mail server.",
+			},
+			"encrypted_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Sensitive:   true,
+				Description: "The encrypted password used to authenticate with the mail server, use `gocd_encrypt_value` to encrypt the password.",
+			},
+			"tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Description: "Enable if the mail server requires TLS/SSL to be used.",
+			},
+			"sender_email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The email address from which GoCD sends the emails.",
+			},
+			"admin_email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The email address of the GoCD administrator, to which the server notifications are sent.",
+			},
+		},
+	}
+}
+
+func resourceMailServerCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	if _, err := defaultConfig.CreateOrUpdateMailServerConfig(getMailServerConfig(d)); err != nil {
+		return diag.Errorf("creating mail server configuration errored with %v", err)
+	}
+
+	d.SetId(mailServerID)
+
+	return resourceMailServerRead(ctx, d, meta)
+}
+
+func resourceMailServerRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	response, err := defaultConfig.GetMailServerConfig()
+	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("mail server configuration not found in GoCD, removing it from the state")
+			d.SetId("")
+
+			return nil
+		}
+
+		return diag.Errorf("getting mail server configuration errored with: %v", err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceHostname:        response.Hostname,
+		utils.TerraformResourcePort:            response.Port,
+		utils.TerraformResourceUserName:        response.UserName,
+		utils.TerraformResourceEncryptPassword: response.EncryptedPassword,
+		utils.TerraformResourceTLS:             response.TLS,
+		utils.TerraformResourceSenderEmail:     response.SenderEmail,
+		utils.TerraformResourceAdminEmail:      response.AdminEmail,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceMailServerUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.HasChanges(
+		utils.TerraformResourceHostname,
+		utils.TerraformResourcePort,
+		utils.TerraformResourceUserName,
+		utils.TerraformResourceEncryptPassword,
+		utils.TerraformResourceTLS,
+		utils.TerraformResourceSenderEmail,
+		utils.TerraformResourceAdminEmail,
+	) {
+		log.Printf("nothing to update so skipping")
+
+		return nil
+	}
+
+	if _, err := defaultConfig.CreateOrUpdateMailServerConfig(getMailServerConfig(d)); err != nil {
+		return diag.Errorf("updating mail server configuration errored with %v", err)
+	}
+
+	return resourceMailServerRead(ctx, d, meta)
+}
+
+func resourceMailServerDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	if err := defaultConfig.DeleteMailServerConfig(); err != nil {
+		if !gocdclient.IsNotFound(err) {
+			return diag.Errorf("deleting mail server configuration errored with: %v", err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getMailServerConfig(d *schema.ResourceData) gocd.MailServerConfig {
+	return gocd.MailServerConfig{
+		Hostname:          utils.String(d.Get(utils.TerraformResourceHostname)),
+		Port:              int64(d.Get(utils.TerraformResourcePort).(int)),
+		UserName:          utils.String(d.Get(utils.TerraformResourceUserName)),
+		EncryptedPassword: utils.String(d.Get(utils.TerraformResourceEncryptPassword)),
+		TLS:               utils.Bool(d.Get(utils.TerraformResourceTLS)),
+		SenderEmail:       utils.String(d.Get(utils.TerraformResourceSenderEmail)),
+		AdminEmail:        utils.String(d.Get(utils.TerraformResourceAdminEmail)),
+	}
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const (
+	maintenanceModeID             = "maintenance_mode"
+	defaultMaintenanceModeTimeout = 1800
+)
+
+func resourceMaintenanceMode() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaintenanceModeCreate,
+		ReadContext:   resourceMaintenanceModeRead,
+		UpdateContext: resourceMaintenanceModeUpdate,
+		DeleteContext: resourceMaintenanceModeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultMaintenanceModeTimeout * time.Second),
+			Update: schema.DefaultTimeout(defaultMaintenanceModeTimeout * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Default:     true,
+				Description: "Whether GoCD should be in maintenance mode, it is enabled/disabled in place.",
+			},
+			"wait_for_drain": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Default:     false,
+				Description: "Enable to wait until the running builds and material updates are drained post enabling the maintenance mode.",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    false,
+				Default:     defaultMaintenanceModeTimeout,
+				Description: "Time to wait for the running builds and material updates to drain (in seconds ex: 1800), bounded by the create/update timeouts of the resource.",
+			},
+			"delay": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    false,
+				Default:     defaultDelay,
+				Description: "Time delay between each check made on the running builds and material updates (in seconds ex: 5).",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user who last enabled/disabled the maintenance mode.",
+			},
+			"updated_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the maintenance mode was last enabled/disabled.",
+			},
+			"has_running_systems": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether there are builds or material updates still running in GoCD.",
+			},
+		},
+	}
+}
+
+func resourceMaintenanceModeCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	if err := setMaintenanceMode(ctx, d, meta); err != nil {
+		return err
+	}
+
+	d.SetId(maintenanceModeID)
+
+	return resourceMaintenanceModeRead(ctx, d, meta)
+}
+
+func resourceMaintenanceModeRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	maintenanceClient := meta.(gocdclient.MaintenanceModeClient)
+
+	response, err := maintenanceClient.GetMaintenanceModeRaw()
+	if err != nil {
+		return diag.Errorf("getting maintenance mode information errored with: %v", err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceEnabled:           response.Enabled,
+		utils.TerraformResourceUpdatedBy:         response.UpdatedBy,
+		utils.TerraformResourceUpdatedOn:         response.UpdatedOn,
+		utils.TerraformResourceHasRunningSystems: response.HasRunningSystems,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceMaintenanceModeUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if !d.HasChange(utils.TerraformResourceEnabled) {
+		log.Printf("nothing to update so skipping")
+
+		return nil
+	}
+
+	if err := setMaintenanceMode(ctx, d, meta); err != nil {
+		return err
+	}
+
+	return resourceMaintenanceModeRead(ctx, d, meta)
+}
+
+func resourceMaintenanceModeDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	response, err := meta.(gocdclient.MaintenanceModeClient).GetMaintenanceModeRaw()
+	if err != nil {
+		return diag.Errorf("getting maintenance mode information errored with: %v", err)
+	}
+
+	// maintenance mode is disabled on destroy, which is the default state of GoCD.
+	if response.Enabled {
+		if err = defaultConfig.DisableMaintenanceMode(); err != nil {
+			return diag.Errorf("disabling maintenance mode errored with: %v", err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// setMaintenanceMode enables/disables the maintenance mode as per the config and waits for the builds to drain when asked to.
+func setMaintenanceMode(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+	maintenanceClient := meta.(gocdclient.MaintenanceModeClient)
+
+	response, err := maintenanceClient.GetMaintenanceModeRaw()
+	if err != nil {
+		return diag.Errorf("getting maintenance mode information errored with: %v", err)
+	}
+
+	enabled := utils.Bool(d.Get(utils.TerraformResourceEnabled))
+
+	switch {
+	case enabled && !response.Enabled:
+		if err = defaultConfig.EnableMaintenanceMode(); err != nil {
+			return diag.Errorf("enabling maintenance mode errored with: %v", err)
+		}
+	case !enabled && response.Enabled:
+		if err = defaultConfig.DisableMaintenanceMode(); err != nil {
+			return diag.Errorf("disabling maintenance mode errored with: %v", err)
+		}
+	}
+
+	if !enabled || !utils.Bool(d.Get(utils.TerraformResourceWaitForDrain)) {
+		return nil
+	}
+
+	timeout := time.Duration(d.Get(utils.TerraformResourceTimeout).(int)) * time.Second
+	delay := time.Duration(d.Get(utils.TerraformResourceDelay).(int)) * time.Second
+
+	err = waitUntil(ctx, timeout, delay, func() (bool, error) {
+		info, err := maintenanceClient.GetMaintenanceModeRaw()
+		if err != nil {
+			return false, err
+		}
+
+		log.Printf("waiting for '%d' building jobs, '%d' scheduled jobs and '%d' material updates to drain",
+			info.BuildingJobs, info.ScheduledJobs, info.MaterialUpdatesInProgress)
+
+		return !info.HasRunningSystems, nil
+	})
+	if err != nil {
+		return diag.Errorf("waiting for the running builds to drain errored with: %v", err)
+	}
+
+	return nil
+}
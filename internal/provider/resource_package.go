@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func resourcePackage() *schema.Resource {
+	configuration := propertiesSchemaResource()
+	configuration.Description = "The list of configuration properties that represent the configuration of the package."
+
+	return &schema.Resource{
+		CreateContext: resourcePackageCreate,
+		ReadContext:   resourcePackageRead,
+		UpdateContext: resourcePackageUpdate,
+		DeleteContext: resourcePackageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePackageImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"package_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The identifier of the package.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    false,
+				Description: "The name of the package.",
+			},
+			"repo_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The identifier of the package repository to which the package belongs.",
+			},
+			"auto_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    false,
+				Default:     true,
+				Description: "Whether GoCD should poll the package repository for new versions of the package.",
+			},
+			"configuration": configuration,
+			"etag": {
+				Type:        schema.TypeString,
+				Required:    false,
+				Computed:    true,
+				ForceNew:    false,
+				Description: "Etag used to track the package.",
+			},
+		},
+	}
+}
+
+func resourcePackageCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	packageClient := meta.(gocdclient.PackageClient)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		resourceID := utils.String(d.Get(utils.TerraformResourcePackageID))
+		id = resourceID
+	}
+
+	if _, err := packageClient.CreatePackageRaw(getPackageAttributes(d)); err != nil {
+		return diag.Errorf("creating package '%s' errored with %v", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourcePackageRead(ctx, d, meta)
+}
+
+func resourcePackageRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	response, err := defaultConfig.GetPackage(d.Id())
+	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("package '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
+		return diag.Errorf("getting package '%s' errored with: %v", d.Id(), err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceName:       response.Name,
+		utils.TerraformResourceRepoID:     response.PackageRepos.ID,
+		utils.TerraformResourceAutoUpdate: response.AutoUpdate,
+		utils.TerraformResourceEtag:       response.ETAG,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return nil
+}
+
+func resourcePackageUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	packageClient := meta.(gocdclient.PackageClient)
+
+	if !d.HasChanges(utils.TerraformResourceName, utils.TerraformResourceAutoUpdate, utils.TerraformResourceConfiguration) {
+		log.Printf("nothing to update so skipping")
+
+		return nil
+	}
+
+	if _, err := packageClient.UpdatePackageRaw(d.Id(), utils.String(d.Get(utils.TerraformResourceEtag)), getPackageAttributes(d)); err != nil {
+		return diag.Errorf("updating package '%s' errored with: %v", d.Id(), err)
+	}
+
+	return resourcePackageRead(ctx, d, meta)
+}
+
+func resourcePackageDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	if err := defaultConfig.DeletePackage(id); err != nil {
+		return diag.Errorf("deleting package '%s' errored with: %v", id, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePackageImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	defaultConfig := meta.(gocd.GoCd)
+
+	packageID := utils.String(d.Id())
+
+	response, err := defaultConfig.GetPackage(packageID)
+	if err != nil {
+		return nil, fmt.Errorf("getting package %s errored with: %w", packageID, err)
+	}
+
+	flattenedConfiguration, err := utils.MapSlice(response.Configuration)
+	if err != nil {
+		return nil, fmt.Errorf("errored while flattening package configuration obtained: %w", err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourcePackageID:     response.ID,
+		utils.TerraformResourceName:          response.Name,
+		utils.TerraformResourceRepoID:        response.PackageRepos.ID,
+		utils.TerraformResourceAutoUpdate:    response.AutoUpdate,
+		utils.TerraformResourceConfiguration: flattenedConfiguration,
+		utils.TerraformResourceEtag:          response.ETAG,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return nil, fmt.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getPackageAttributes builds the request body of the package, gocd.Package is not used since it drops auto_update when set to false.
+func getPackageAttributes(d *schema.ResourceData) map[string]any {
+	return map[string]any{
+		"id":                              utils.String(d.Get(utils.TerraformResourcePackageID)),
+		utils.TerraformResourceName:       utils.String(d.Get(utils.TerraformResourceName)),
+		utils.TerraformResourceAutoUpdate: utils.Bool(d.Get(utils.TerraformResourceAutoUpdate)),
+		"package_repo": map[string]string{
+			"id": utils.String(d.Get(utils.TerraformResourceRepoID)),
+		},
+		utils.TerraformResourceConfiguration: getPluginConfiguration(d.Get(utils.TerraformResourceConfiguration)),
+	}
+}
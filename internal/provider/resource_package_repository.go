@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const (
+	defaultPackagePluginVersion = "1"
+	pluginMetadataID            = "id"
+	pluginMetadataVersion       = "version"
+)
+
+func resourcePackageRepository() *schema.Resource {
+	configuration := propertiesSchemaResource()
+	configuration.Description = "The list of configuration properties that represent the configuration of the package repository."
+
+	return &schema.Resource{
+		CreateContext: resourcePackageRepositoryCreate,
+		ReadContext:   resourcePackageRepositoryRead,
+		UpdateContext: resourcePackageRepositoryUpdate,
+		DeleteContext: resourcePackageRepositoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePackageRepositoryImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"repo_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The identifier of the package repository.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    false,
+				Description: "The name of the package repository.",
+			},
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The identifier of the package material plugin used by the package repository.",
+			},
+			"plugin_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    false,
+				Default:     defaultPackagePluginVersion,
+				Description: "The version of the package material plugin used by the package repository.",
+			},
+			"configuration": configuration,
+			"etag": {
+				Type:        schema.TypeString,
+				Required:    false,
+				Computed:    true,
+				ForceNew:    false,
+				Description: "Etag used to track the package repository.",
+			},
+		},
+	}
+}
+
+func resourcePackageRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		resourceID := utils.String(d.Get(utils.TerraformResourceRepoID))
+		id = resourceID
+	}
+
+	if _, err := defaultConfig.CreatePackageRepository(getPackageRepository(d)); err != nil {
+		return diag.Errorf("creating package repository '%s' errored with %v", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourcePackageRepositoryRead(ctx, d, meta)
+}
+
+func resourcePackageRepositoryRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	response, err := defaultConfig.GetPackageRepository(d.Id())
+	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("package repository '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
+		return diag.Errorf("getting package repository '%s' errored with: %v", d.Id(), err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceName:          response.Name,
+		utils.TerraformResourcePluginID:      response.PluginMetaData[pluginMetadataID],
+		utils.TerraformResourcePluginVersion: response.PluginMetaData[pluginMetadataVersion],
+		utils.TerraformResourceEtag:          response.ETAG,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return nil
+}
+
+func resourcePackageRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.HasChanges(utils.TerraformResourceName, utils.TerraformResourcePluginVersion, utils.TerraformResourceConfiguration) {
+		log.Printf("nothing to update so skipping")
+
+		return nil
+	}
+
+	cfg := getPackageRepository(d)
+	cfg.ETAG = utils.String(d.Get(utils.TerraformResourceEtag))
+
+	if _, err := defaultConfig.UpdatePackageRepository(cfg); err != nil {
+		return diag.Errorf("updating package repository '%s' errored with: %v", cfg.ID, err)
+	}
+
+	return resourcePackageRepositoryRead(ctx, d, meta)
+}
+
+func resourcePackageRepositoryDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	if err := defaultConfig.DeletePackageRepository(id); err != nil {
+		return diag.Errorf("deleting package repository '%s' errored with: %v", id, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePackageRepositoryImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	defaultConfig := meta.(gocd.GoCd)
+
+	repoID := utils.String(d.Id())
+
+	response, err := defaultConfig.GetPackageRepository(repoID)
+	if err != nil {
+		return nil, fmt.Errorf("getting package repository %s errored with: %w", repoID, err)
+	}
+
+	flattenedConfiguration, err := utils.MapSlice(response.Configuration)
+	if err != nil {
+		return nil, fmt.Errorf("errored while flattening package repository configuration obtained: %w", err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceRepoID:        response.ID,
+		utils.TerraformResourceName:          response.Name,
+		utils.TerraformResourcePluginID:      response.PluginMetaData[pluginMetadataID],
+		utils.TerraformResourcePluginVersion: response.PluginMetaData[pluginMetadataVersion],
+		utils.TerraformResourceConfiguration: flattenedConfiguration,
+		utils.TerraformResourceEtag:          response.ETAG,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return nil, fmt.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getPackageRepository(d *schema.ResourceData) gocd.PackageRepository {
+	return gocd.PackageRepository{
+		ID:   utils.String(d.Get(utils.TerraformResourceRepoID)),
+		Name: utils.String(d.Get(utils.TerraformResourceName)),
+		PluginMetaData: map[string]string{
+			pluginMetadataID:      utils.String(d.Get(utils.TerraformResourcePluginID)),
+			pluginMetadataVersion: utils.String(d.Get(utils.TerraformResourcePluginVersion)),
+		},
+		Configuration: getPluginConfiguration(d.Get(utils.TerraformResourceConfiguration)),
+	}
+}
@@ -0,0 +1,33 @@
+//nolint:testpackage
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGetPackageAttributes(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePackage().Schema, map[string]any{
+		"package_id":  "helm-chart",
+		"name":        "helm-chart",
+		"repo_id":     "helm-repo",
+		"auto_update": false,
+		"configuration": []any{
+			map[string]any{"key": "PACKAGE_SPEC", "value": "gocd-server"},
+		},
+	})
+
+	body, err := json.Marshal(getPackageAttributes(d))
+	if err != nil {
+		t.Fatalf("marshalling package attributes errored with: %v", err)
+	}
+
+	expected := `{"auto_update":false,"configuration":[{"key":"PACKAGE_SPEC","value":"gocd-server"}],` +
+		`"id":"helm-chart","name":"helm-chart","package_repo":{"id":"helm-repo"}}`
+
+	if string(body) != expected {
+		t.Errorf("expected package attributes %s, got %s", expected, string(body))
+	}
+}
@@ -3,14 +3,15 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/nikhilsbhat/common/content"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
-	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 )
 
@@ -20,6 +21,9 @@ func resourcePipeline() *schema.Resource {
 		ReadContext:   resourcePipelineRead,
 		UpdateContext: resourcePipelineUpdate,
 		DeleteContext: resourcePipelineDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePipelineImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -37,13 +41,45 @@ func resourcePipeline() *schema.Resource {
 				Description: "Name of the pipeline group that this pipeline should be part of.",
 			},
 			"config": {
-				Type:        schema.TypeString,
-				Optional:    false,
-				Required:    true,
-				Computed:    false,
-				ForceNew:    false,
-				Description: "The config of the pipeline to be created (it can take in yaml/json data based on the attribute set).",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Required:         false,
+				Computed:         false,
+				ForceNew:         false,
+				ExactlyOneOf:     []string{utils.TerraformResourceConfig, utils.TerraformResourceMaterials},
+				DiffSuppressFunc: suppressEquivalentConfigDiff,
+				Description: "The config of the pipeline to be created (it can take in yaml/json data based on the attribute set). " +
+					"The config is read back from GoCD, so changes made to the pipeline outside terraform would show up in the plan. " +
+					"Changes in key order, indentation, format or fields set to GoCD defaults are not treated as a diff. " +
+					"Either `config` or the blocks `materials`, `stages` etc. should be set.",
+			},
+			"label_template": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{utils.TerraformResourceConfig},
+				Description:   "The label template to customise the pipeline instance label. Cannot be used along with `config`.",
 			},
+			"lock_behavior": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{utils.TerraformResourceConfig},
+				Description:   "The pipeline locking behavior, can be one of `lockOnFailure`, `unlockWhenFinished`, `none`. Cannot be used along with `config`.",
+			},
+			"template": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      false,
+				ConflictsWith: []string{utils.TerraformResourceConfig},
+				Description:   "The name of the template used by the pipeline. Cannot be used along with `config` or `stages`.",
+			},
+			"materials":             pipelineMaterialsSchema(),
+			"stages":                pipelineStagesSchema(),
+			"environment_variables": pipelineEnvironmentVariablesSchema(),
+			"parameters":            pipelineParametersSchema(),
+			"timer":                 pipelineTimerSchema(),
+			"tracking_tool":         pipelineTrackingToolSchema(),
 			"pause_on_creation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -98,33 +134,34 @@ func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta an
 		},
 	}
 
-	obj := content.Object(utils.String(d.Get(utils.TerraformResourceConfig)))
-	logger := logrus.New()
-	obj.CheckFileType(logger)
-
 	var configMap map[string]any
 
-	switch objType := obj.CheckFileType(logger); objType {
-	case content.FileTypeJSON:
-		if err := json.Unmarshal([]byte(obj.String()), &configMap); err != nil {
+	if config := utils.String(d.Get(utils.TerraformResourceConfig)); len(config) != 0 {
+		decodedConfig, isYAML, err := decodeConfig(config)
+		if err != nil {
 			return diag.Errorf("decoding pipeline config errored with: %v", err)
 		}
 
-		pipelineCfg.Config = configMap
-	case content.FileTypeYAML:
-		if err := yaml.Unmarshal([]byte(obj.String()), &configMap); err != nil {
-			return diag.Errorf("decoding pipeline config errored with: %v", err)
+		if isYAML {
+			if err = d.Set(utils.TerraformResourceYAML, true); err != nil {
+				return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceYAML, err)
+			}
 		}
 
-		if err := d.Set(utils.TerraformResourceYAML, true); err != nil {
-			return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceYAML, err)
+		configMap = decodedConfig
+	} else {
+		structuredCfg, err := getStructuredPipelineConfig(d)
+		if err != nil {
+			return diag.Errorf("building pipeline config errored with: %v", err)
 		}
 
-		pipelineCfg.Config = configMap
-	default:
-		return diag.Errorf("pipeline config type is unknown")
+		if configMap, err = getPipelineConfigMap(structuredCfg); err != nil {
+			return diag.Errorf("translating pipeline config errored with: %v", err)
+		}
 	}
 
+	pipelineCfg.Config = configMap
+
 	if pipelineCfg.Config["name"] != id {
 		return diag.Errorf("pipeline name passed under attribute and pipeline config are not same, make sure to pass the same values, "+
 			"current values: 'attribute:%s config:%s'", id, pipelineCfg.Config["name"].(string))
@@ -140,15 +177,59 @@ func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta an
 }
 
 func resourcePipelineRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	pipelineClient := meta.(gocdclient.PipelineConfigClient)
 
 	name := utils.String(d.Get(utils.TerraformResourceName))
 
-	response, err := defaultConfig.GetPipelineConfig(name)
+	response, err := pipelineClient.GetPipelineConfigRaw(name)
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("pipeline '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("getting pipeline config %s errored with: %v", name, err)
 	}
 
+	if len(response.Group) != 0 {
+		if err = d.Set(utils.TerraformResourceGroup, response.Group); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceGroup, err)
+		}
+	}
+
+	response.Config = normalizePipelineConfig(response.Config)
+
+	// pipelines declared with blocks instead of config are read back into the same blocks.
+	if len(utils.String(d.Get(utils.TerraformResourceConfig))) == 0 {
+		if err = setStructuredPipelineConfig(d, response.Config); err != nil {
+			return diag.Errorf("setting pipeline config '%s' errored with: %v", name, err)
+		}
+
+		if err = d.Set(utils.TerraformResourceEtag, response.ETAG); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceEtag, err)
+		}
+
+		return nil
+	}
+
+	changed, err := pipelineConfigChanged(utils.String(d.Get(utils.TerraformResourceConfig)), response.Config)
+	if err != nil {
+		return diag.Errorf("comparing pipeline config '%s' with the one obtained from GoCD errored with: %v", name, err)
+	}
+
+	if changed {
+		pipelineCfg, err := getPipelineConfigYaml(response, utils.Bool(d.Get(utils.TerraformResourceYAML)))
+		if err != nil {
+			return diag.Errorf("translating pipeline config to json/yaml errored with: %v", err)
+		}
+
+		if err = d.Set(utils.TerraformResourceConfig, pipelineCfg); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceConfig, err)
+		}
+	}
+
 	if err = d.Set(utils.TerraformResourceEtag, response.ETAG); err != nil {
 		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceEtag, err)
 	}
@@ -159,7 +240,7 @@ func resourcePipelineRead(_ context.Context, d *schema.ResourceData, meta any) d
 func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	defaultConfig := meta.(gocd.GoCd)
 
-	if !d.HasChanges(utils.TerraformResourceConfig) {
+	if !d.HasChanges(append([]string{utils.TerraformResourceConfig}, pipelineStructuredAttributes...)...) {
 		log.Printf("nothing to update so skipping")
 
 		return nil
@@ -176,13 +257,26 @@ func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta an
 	config := utils.String(d.Get(utils.TerraformResourceConfig))
 
 	isYAML := utils.Bool(d.Get(utils.TerraformResourceYAML))
-	if isYAML {
+
+	switch {
+	case len(config) == 0:
+		structuredCfg, err := getStructuredPipelineConfig(d)
+		if err != nil {
+			return diag.Errorf("building pipeline config errored with: %v", err)
+		}
+
+		if configMap, err = getPipelineConfigMap(structuredCfg); err != nil {
+			return diag.Errorf("translating pipeline config errored with: %v", err)
+		}
+
+		pluginConfig.Config = configMap
+	case isYAML:
 		if err := yaml.Unmarshal([]byte(config), &configMap); err != nil {
 			return diag.Errorf("decoding yaml pipeline config errored with: %v", err)
 		}
 
 		pluginConfig.Config = configMap
-	} else {
+	default:
 		if err := json.Unmarshal([]byte(config), &configMap); err != nil {
 			return diag.Errorf("decoding json pipeline config errored with: %v", err)
 		}
@@ -216,3 +310,112 @@ func resourcePipelineDelete(_ context.Context, d *schema.ResourceData, meta any)
 
 	return nil
 }
+
+func resourcePipelineImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	defaultConfig := meta.(gocd.GoCd)
+	pipelineClient := meta.(gocdclient.PipelineConfigClient)
+
+	name, isYAML, err := parsePipelineImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := pipelineClient.GetPipelineConfigRaw(name)
+	if err != nil {
+		return nil, fmt.Errorf("getting pipeline config %s errored with: %w", name, err)
+	}
+
+	group, err := getPipelineGroupName(defaultConfig, name)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Config = normalizePipelineConfig(response.Config)
+
+	pipelineCfg, err := getPipelineConfigYaml(response, isYAML)
+	if err != nil {
+		return nil, fmt.Errorf("translating pipeline config to json/yaml errored with: %w", err)
+	}
+
+	d.SetId(name)
+
+	attributes := map[string]any{
+		utils.TerraformResourceName:   name,
+		utils.TerraformResourceGroup:  group,
+		utils.TerraformResourceConfig: pipelineCfg,
+		utils.TerraformResourceYAML:   isYAML,
+		utils.TerraformResourceEtag:   response.ETAG,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return nil, fmt.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parsePipelineImportID parses the import ID of the form name[:yaml|json] into the pipeline name and whether the config is YAML.
+func parsePipelineImportID(id string) (string, bool, error) {
+	name, format, _ := strings.Cut(id, ":")
+
+	switch format {
+	case "", "json":
+		return name, false, nil
+	case "yaml":
+		return name, true, nil
+	default:
+		return "", false, fmt.Errorf("unsupported format '%s' in import ID '%s', the ID should be of the form 'name', 'name:yaml' or 'name:json'", format, id)
+	}
+}
+
+// getPipelineGroupName looks up the pipeline group which the pipeline is part of.
+func getPipelineGroupName(defaultConfig gocd.GoCd, pipeline string) (string, error) {
+	groups, err := defaultConfig.GetPipelineGroups()
+	if err != nil {
+		return "", fmt.Errorf("getting pipeline groups errored with: %w", err)
+	}
+
+	for _, group := range groups {
+		for _, groupPipeline := range group.Pipelines {
+			if groupPipeline.Name == pipeline {
+				return group.Name, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("pipeline '%s' is not part of any pipeline group", pipeline)
+}
+
+// normalizePipelineConfig strips the fields populated by GoCD server, which are never part of the config managed by terraform.
+func normalizePipelineConfig(config map[string]any) map[string]any {
+	normalized, _ := stripServerFields(config).(map[string]any)
+	for _, field := range pipelineServerFields {
+		delete(normalized, field)
+	}
+
+	return normalized
+}
+
+var pipelineServerFields = []string{"origin", "group"}
+
+// pipelineConfigChanged compares the config from the state with the one obtained from GoCD,
+// irrespective of whether the config was declared in yaml or json.
+func pipelineConfigChanged(config string, liveConfig map[string]any) (bool, error) {
+	if len(strings.TrimSpace(config)) == 0 {
+		return true, nil
+	}
+
+	configMap, _, err := decodeConfig(config)
+	if err != nil {
+		return false, err
+	}
+
+	equivalent, err := configsEquivalent(normalizePipelineConfig(configMap), liveConfig)
+	if err != nil {
+		return false, err
+	}
+
+	return !equivalent, nil
+}
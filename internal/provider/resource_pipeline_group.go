@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -96,6 +97,13 @@ func resourcePipelineGroupRead(_ context.Context, d *schema.ResourceData, meta a
 
 	response, err := defaultConfig.GetPipelineGroup(name)
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("pipeline group '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("getting pipeline group '%s' errored with: %v", name, err)
 	}
 
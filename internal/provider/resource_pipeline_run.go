@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const (
+	defaultPipelineRunTimeout = 3600
+
+	pipelineRunResultPassed    = "Passed"
+	pipelineRunResultFailed    = "Failed"
+	pipelineRunResultCancelled = "Cancelled"
+	pipelineRunResultBuilding  = "Building"
+	pipelineRunResultWaiting   = "Waiting"
+	stageApprovalTypeManual    = "manual"
+)
+
+func resourcePipelineRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePipelineRunCreate,
+		ReadContext:   resourcePipelineRunRead,
+		UpdateContext: resourcePipelineRunUpdate,
+		DeleteContext: resourcePipelineRunDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultPipelineRunTimeout * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"pipeline": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The name of the pipeline to be triggered.",
+			},
+			"environment_variables": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The environment variables to be overridden while triggering the pipeline.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"secure_environment_variables": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The secure environment variables to be overridden while triggering the pipeline.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"materials": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The revisions of the materials to be used for the run, latest revisions are used for the materials not listed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fingerprint": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The fingerprint of the material.",
+						},
+						"revision": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The revision of the material to be used for the run.",
+						},
+					},
+				},
+			},
+			"update_materials_before_scheduling": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether GoCD should check the materials for new revisions before triggering the pipeline.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, would trigger the pipeline again.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Default:     false,
+				Description: "Enable to wait until the run passes, fails, gets cancelled or reaches a stage that needs manual approval.",
+			},
+			"timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    false,
+				Default:     defaultPipelineRunTimeout,
+				Description: "Total time to wait for the run to be scheduled and completed (in seconds ex: 3600), bounded by the create timeout of the resource.",
+			},
+			"delay": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    false,
+				Default:     defaultDelay,
+				Description: "Time delay between each check made on the status of the run (in seconds ex: 5).",
+			},
+			"counter": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The counter of the run triggered.",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The label of the run triggered.",
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The result of the run, one of `Building`, `Passed`, `Failed`, `Cancelled` " +
+					"or `Waiting` when the run stopped at a stage that needs manual approval.",
+			},
+		},
+	}
+}
+
+func resourcePipelineRunCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	runClient := meta.(gocdclient.PipelineRunClient)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+	deadline := time.Now().Add(time.Duration(d.Get(utils.TerraformResourceTimeout).(int)) * time.Second)
+	delay := time.Duration(d.Get(utils.TerraformResourceDelay).(int)) * time.Second
+
+	previousCounter, err := getLatestPipelineCounter(runClient, pipeline)
+	if err != nil {
+		return diag.Errorf("getting latest run of pipeline '%s' errored with: %v", pipeline, err)
+	}
+
+	if err = runClient.SchedulePipelineRaw(pipeline, getPipelineSchedule(d)); err != nil {
+		return diag.Errorf("triggering pipeline '%s' errored with: %v", pipeline, err)
+	}
+
+	// GoCD schedules the pipeline asynchronously, hence the run is identified once it shows up in the pipeline history.
+	var counter int
+
+	err = waitUntil(ctx, time.Until(deadline), delay, func() (bool, error) {
+		latestCounter, err := getLatestPipelineCounter(runClient, pipeline)
+		if err != nil {
+			return false, err
+		}
+
+		counter = latestCounter
+
+		return latestCounter > previousCounter, nil
+	})
+	if err != nil {
+		return diag.Errorf("waiting for the pipeline '%s' to be scheduled errored with: %v", pipeline, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", pipeline, counter))
+
+	if err = d.Set(utils.TerraformResourceCounter, counter); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceCounter, err)
+	}
+
+	if utils.Bool(d.Get(utils.TerraformResourceWaitForCompletion)) {
+		// the run shares the timeout with the wait for it to be scheduled.
+		err = waitUntil(ctx, time.Until(deadline), delay, func() (bool, error) {
+			instance, err := runClient.GetPipelineInstanceRaw(pipeline, counter)
+			if err != nil {
+				return false, err
+			}
+
+			result, done := getPipelineRunResult(instance)
+			log.Printf("run '%d' of pipeline '%s' is in state '%s'", counter, pipeline, result)
+
+			return done, nil
+		})
+		if err != nil {
+			return diag.Errorf("waiting for the run '%d' of pipeline '%s' to complete errored with: %v", counter, pipeline, err)
+		}
+	}
+
+	return resourcePipelineRunRead(ctx, d, meta)
+}
+
+func resourcePipelineRunRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	runClient := meta.(gocdclient.PipelineRunClient)
+
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+	counter := d.Get(utils.TerraformResourceCounter).(int)
+
+	response, err := runClient.GetPipelineInstanceRaw(pipeline, counter)
+	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("pipeline run '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
+		return diag.Errorf("getting run '%d' of pipeline '%s' errored with: %v", counter, pipeline, err)
+	}
+
+	result, _ := getPipelineRunResult(response)
+
+	if err = d.Set(utils.TerraformResourceLabel, response.Label); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceLabel, err)
+	}
+
+	if err = d.Set(utils.TerraformResourceResult, result); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceResult, err)
+	}
+
+	return nil
+}
+
+func resourcePipelineRunUpdate(_ context.Context, _ *schema.ResourceData, _ any) diag.Diagnostics {
+	// only the attributes controlling the wait can change in place, which are used only while triggering the pipeline.
+	log.Printf("nothing to update so skipping")
+
+	return nil
+}
+
+func resourcePipelineRunDelete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	// runs of a pipeline cannot be deleted from GoCD, hence it is only removed from the state.
+	d.SetId("")
+
+	return nil
+}
+
+func getLatestPipelineCounter(runClient gocdclient.PipelineRunClient, pipeline string) (int, error) {
+	history, err := runClient.GetPipelineHistoryRaw(pipeline, gocdclient.MinPipelineHistoryPageSize, "")
+	if err != nil {
+		return 0, err
+	}
+
+	if len(history.Pipelines) == 0 {
+		return 0, nil
+	}
+
+	return history.Pipelines[0].Counter, nil
+}
+
+func getPipelineSchedule(d *schema.ResourceData) map[string]any {
+	schedule := map[string]any{
+		utils.TerraformResourceUpdateMaterials: utils.Bool(d.Get(utils.TerraformResourceUpdateMaterials)),
+	}
+
+	envVars := make([]map[string]any, 0)
+	envVars = append(envVars, getScheduleEnvironmentVariables(d.Get(utils.TerraformResourceEnvVar), false)...)
+	envVars = append(envVars, getScheduleEnvironmentVariables(d.Get(utils.TerraformResourceSecureEnvVar), true)...)
+
+	if len(envVars) != 0 {
+		schedule[utils.TerraformResourceEnvVar] = envVars
+	}
+
+	if materials := d.Get(utils.TerraformResourceMaterials).([]any); len(materials) != 0 {
+		scheduleMaterials := make([]map[string]any, 0, len(materials))
+		for _, material := range materials {
+			scheduleMaterials = append(scheduleMaterials, material.(map[string]any))
+		}
+
+		schedule[utils.TerraformResourceMaterials] = scheduleMaterials
+	}
+
+	return schedule
+}
+
+func getScheduleEnvironmentVariables(configs any, secure bool) []map[string]any {
+	envVars := configs.(map[string]any)
+
+	names := make([]string, 0, len(envVars))
+	for name := range envVars {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	scheduleEnvVars := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		scheduleEnvVars = append(scheduleEnvVars, map[string]any{
+			"name":   name,
+			"value":  utils.String(envVars[name]),
+			"secure": secure,
+		})
+	}
+
+	return scheduleEnvVars
+}
+
+// getPipelineRunResult returns the result of the pipeline run along with whether the run reached a terminal state,
+// a run that stopped at a stage which needs manual approval is considered terminal.
+func getPipelineRunResult(instance gocdclient.PipelineInstance) (string, bool) {
+	if len(instance.Stages) == 0 {
+		return pipelineRunResultBuilding, false
+	}
+
+	for _, stage := range instance.Stages {
+		if !stage.Scheduled {
+			if strings.EqualFold(stage.ApprovalType, stageApprovalTypeManual) {
+				return pipelineRunResultWaiting, true
+			}
+
+			return pipelineRunResultBuilding, false
+		}
+
+		switch stage.Result {
+		case pipelineRunResultPassed:
+			continue
+		case pipelineRunResultFailed, pipelineRunResultCancelled:
+			return stage.Result, true
+		default:
+			return pipelineRunResultBuilding, false
+		}
+	}
+
+	return pipelineRunResultPassed, true
+}
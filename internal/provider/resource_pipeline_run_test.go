@@ -0,0 +1,74 @@
+//nolint:testpackage
+package provider
+
+import (
+	"testing"
+
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+)
+
+func TestGetPipelineRunResult(t *testing.T) {
+	tests := map[string]struct {
+		stages []gocdclient.StageInstance
+		result string
+		done   bool
+	}{
+		"not scheduled yet": {
+			result: "Building",
+		},
+		"all stages passed": {
+			stages: []gocdclient.StageInstance{
+				{Name: "build", Scheduled: true, Result: "Passed"},
+				{Name: "deploy", Scheduled: true, Result: "Passed"},
+			},
+			result: "Passed",
+			done:   true,
+		},
+		"stage in progress": {
+			stages: []gocdclient.StageInstance{
+				{Name: "build", Scheduled: true, Result: "Passed"},
+				{Name: "deploy", Scheduled: true, Result: "Unknown", Status: "Building"},
+			},
+			result: "Building",
+		},
+		"next stage yet to be scheduled": {
+			stages: []gocdclient.StageInstance{
+				{Name: "build", Scheduled: true, Result: "Passed"},
+				{Name: "deploy", ApprovalType: "success"},
+			},
+			result: "Building",
+		},
+		"stage failed": {
+			stages: []gocdclient.StageInstance{
+				{Name: "build", Scheduled: true, Result: "Failed"},
+				{Name: "deploy", ApprovalType: "success"},
+			},
+			result: "Failed",
+			done:   true,
+		},
+		"stage cancelled": {
+			stages: []gocdclient.StageInstance{
+				{Name: "build", Scheduled: true, Result: "Cancelled"},
+			},
+			result: "Cancelled",
+			done:   true,
+		},
+		"waiting for manual approval": {
+			stages: []gocdclient.StageInstance{
+				{Name: "build", Scheduled: true, Result: "Passed"},
+				{Name: "deploy", ApprovalType: "manual"},
+			},
+			result: "Waiting",
+			done:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, done := getPipelineRunResult(gocdclient.PipelineInstance{Name: "sample", Counter: 1, Stages: test.stages})
+			if result != test.result || done != test.done {
+				t.Errorf("expected result '%s' and done '%t', got '%s' and '%t'", test.result, test.done, result, done)
+			}
+		})
+	}
+}
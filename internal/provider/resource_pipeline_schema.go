@@ -0,0 +1,882 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+	"github.com/spf13/cast"
+)
+
+// pipelineStructuredAttributes holds the attributes used when the pipeline is declared with blocks instead of `config`.
+var pipelineStructuredAttributes = []string{
+	utils.TerraformResourceLabelTemplate,
+	utils.TerraformResourceLockBehavior,
+	utils.TerraformResourceTemplate,
+	utils.TerraformResourceMaterials,
+	utils.TerraformResourceStages,
+	utils.TerraformResourceEnvVar,
+	utils.TerraformResourceParameters,
+	utils.TerraformResourceTimer,
+	utils.TerraformResourceTrackingTool,
+}
+
+func pipelineMaterialsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		Computed:      false,
+		ConflictsWith: []string{utils.TerraformResourceConfig},
+		Description:   "The list of materials to be used by the pipeline. Cannot be used along with `config`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Computed:    false,
+					Description: "The type of a material. Can be one of git, svn, hg, p4, tfs, dependency, package, plugin.",
+				},
+				"fingerprint": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "The fingerprint of the material.",
+				},
+				"attributes": attributesSchema(),
+			},
+		},
+	}
+}
+
+func pipelineStagesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		Computed:      false,
+		ConflictsWith: []string{utils.TerraformResourceConfig, utils.TerraformResourceTemplate},
+		Description:   "The list of stages of the pipeline. Cannot be used along with `config` or `template`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the stage.",
+				},
+				"fetch_materials": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Whether to perform material update/checkout before running the jobs of the stage.",
+				},
+				"clean_working_directory": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether to delete the working directory every time the stage runs.",
+				},
+				"never_cleanup_artifacts": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Never cleanup artifacts for this stage, if purging artifacts is configured at the server level.",
+				},
+				"approval": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Computed:    true,
+					MaxItems:    1,
+					Description: "The approval configuration of the stage.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     "success",
+								Description: "The type of the approval, can be one of `success`, `manual`.",
+							},
+							"allow_only_on_success": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Allow the stage to be scheduled only if the previous stage succeeded.",
+							},
+							"users": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "List of users who are authorized to approve the stage.",
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"roles": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "List of roles who are authorized to approve the stage.",
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"environment_variables": environmentsSchemaResource(),
+				"jobs":                  pipelineJobsSchema(),
+			},
+		},
+	}
+}
+
+func pipelineJobsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		Description: "The list of jobs of the stage.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the job.",
+				},
+				"run_instance_count": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The number of instances of the job to run, can be a number or `all` to run on all the agents.",
+				},
+				"timeout": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "The time period (in minutes) after which the job would be cancelled if it is inactive.",
+				},
+				"elastic_profile_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The id of the elastic agent profile to be used by the job.",
+				},
+				"resources": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The list of resources that the agent should have to run the job.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"environment_variables": environmentsSchemaResource(),
+				"tabs": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The list of custom tabs of the job.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The name of the tab.",
+							},
+							"path": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The path of the artifact to be rendered in the tab.",
+							},
+						},
+					},
+				},
+				"artifacts": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The list of artifacts published by the job.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The type of the artifact, can be one of `build`, `test`, `external`.",
+							},
+							"source": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The file or folders to publish to the server.",
+							},
+							"destination": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The destination is relative to the artifacts folder of the current instance on the server side.",
+							},
+							"id": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The identifier of the external artifact.",
+							},
+							"store_id": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The artifact store id to which the external artifact would be published.",
+							},
+							"configuration": {
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Description: "The configuration of the external artifact.",
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"tasks": pipelineTasksSchema(),
+			},
+		},
+	}
+}
+
+func pipelineTasksSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		Description: "The list of tasks that would run as part of the job.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The type of the task, can be one of `exec`, `fetch`, `pluggable_task`.",
+				},
+				"attributes": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MaxItems:    1,
+					Description: "The attributes of the task, the ones to be set depends on the type of the task.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The command to be executed (`exec` task).",
+							},
+							"arguments": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "The list of arguments to be passed to the command (`exec` task).",
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"working_directory": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The directory in which the command would be executed (`exec` task).",
+							},
+							"run_if": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Computed:    true,
+								Description: "The statuses of the job on which the task would run, can be any of `passed`, `failed`, `any`.",
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"artifact_origin": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The origin of the artifact to fetch, can be one of `gocd`, `external` (`fetch` task).",
+							},
+							"pipeline": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The pipeline from which the artifact would be fetched (`fetch` task).",
+							},
+							"stage": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The stage from which the artifact would be fetched (`fetch` task).",
+							},
+							"job": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The job from which the artifact would be fetched (`fetch` task).",
+							},
+							"source": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The path of the artifact to be fetched (`fetch` task).",
+							},
+							"is_source_a_file": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Whether the artifact to be fetched is a file or a directory (`fetch` task).",
+							},
+							"destination": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The path where the artifact would be fetched to (`fetch` task).",
+							},
+							"artifact_id": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The id of the external artifact to be fetched (`fetch` task).",
+							},
+							"plugin_id": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The id of the task plugin (`pluggable_task` task).",
+							},
+							"plugin_version": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "The version of the task plugin (`pluggable_task` task).",
+							},
+							"configuration": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Description: "The list of configuration properties of the plugin (`pluggable_task`/`fetch` task).",
+								Elem:        propertiesSchemaResource().Elem,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func pipelineEnvironmentVariablesSchema() *schema.Schema {
+	envSchema := environmentsSchemaResource()
+	envSchema.ConflictsWith = []string{utils.TerraformResourceConfig}
+	envSchema.Description = "The list of environment variables that will be passed to all tasks (commands) that are part of this pipeline. " +
+		"Cannot be used along with `config`."
+
+	return envSchema
+}
+
+func pipelineParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeSet,
+		Optional:      true,
+		Computed:      false,
+		ConflictsWith: []string{utils.TerraformResourceConfig},
+		Description:   "The list of parameters of the pipeline. Cannot be used along with `config`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The name of the parameter.",
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The value of the parameter.",
+				},
+			},
+		},
+	}
+}
+
+func pipelineTimerSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		Computed:      false,
+		MaxItems:      1,
+		ConflictsWith: []string{utils.TerraformResourceConfig},
+		Description:   "The timer to schedule the pipeline. Cannot be used along with `config`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"spec": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The cron-like schedule to build the pipeline.",
+				},
+				"only_on_changes": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Run only if the pipeline has new material modifications.",
+				},
+			},
+		},
+	}
+}
+
+func pipelineTrackingToolSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		Computed:      false,
+		MaxItems:      1,
+		ConflictsWith: []string{utils.TerraformResourceConfig},
+		Description:   "The tracking tool to link the commit messages with the issues. Cannot be used along with `config`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "generic",
+					Description: "The type of the tracking tool.",
+				},
+				"url_pattern": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The URL for the tracking tool, it must contain the string `${ID}`.",
+				},
+				"regex": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The regular expression to identify card or bug numbers from the commit message.",
+				},
+			},
+		},
+	}
+}
+
+// getStructuredPipelineConfig builds the pipeline config from the blocks declared in the resource.
+func getStructuredPipelineConfig(d *schema.ResourceData) (gocd.PipelineConfig, error) {
+	materials, err := getPipelineMaterials(d.Get(utils.TerraformResourceMaterials))
+	if err != nil {
+		return gocd.PipelineConfig{}, fmt.Errorf("reading materials errored with: %w", err)
+	}
+
+	stages, err := getPipelineStages(d.Get(utils.TerraformResourceStages))
+	if err != nil {
+		return gocd.PipelineConfig{}, fmt.Errorf("reading stages errored with: %w", err)
+	}
+
+	pipelineCfg := gocd.PipelineConfig{
+		Name:                 utils.String(d.Get(utils.TerraformResourceName)),
+		Group:                utils.String(d.Get(utils.TerraformResourceGroup)),
+		LabelTemplate:        utils.String(d.Get(utils.TerraformResourceLabelTemplate)),
+		LockBehavior:         utils.String(d.Get(utils.TerraformResourceLockBehavior)),
+		Template:             utils.String(d.Get(utils.TerraformResourceTemplate)),
+		Parameters:           getPipelineParameters(d.Get(utils.TerraformResourceParameters)),
+		EnvironmentVariables: getPipelineEnvironmentVariables(d.Get(utils.TerraformResourceEnvVar)),
+		Materials:            materials,
+		Stages:               stages,
+	}
+
+	if timers := d.Get(utils.TerraformResourceTimer).([]any); len(timers) != 0 && timers[0] != nil {
+		timer := timers[0].(map[string]any)
+		pipelineCfg.Timer = gocd.PipelineTimerConfig{
+			Spec:          utils.String(timer["spec"]),
+			OnlyOnChanges: utils.Bool(timer["only_on_changes"]),
+		}
+	}
+
+	if trackingTools := d.Get(utils.TerraformResourceTrackingTool).([]any); len(trackingTools) != 0 && trackingTools[0] != nil {
+		trackingTool := trackingTools[0].(map[string]any)
+		pipelineCfg.TrackingTool.Type = utils.String(trackingTool["type"])
+		pipelineCfg.TrackingTool.Attributes.URLPattern = utils.String(trackingTool["url_pattern"])
+		pipelineCfg.TrackingTool.Attributes.Regex = utils.String(trackingTool["regex"])
+	}
+
+	return pipelineCfg, nil
+}
+
+// getPipelineConfigMap translates the pipeline config to the payload accepted by the pipeline config API.
+func getPipelineConfigMap(pipelineCfg gocd.PipelineConfig) (map[string]any, error) {
+	out, err := json.Marshal(pipelineCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var configMap map[string]any
+	if err = json.Unmarshal(out, &configMap); err != nil {
+		return nil, err
+	}
+
+	for _, field := range []string{"origin", "create_options", "config", "etag", "group"} {
+		delete(configMap, field)
+	}
+
+	if len(pipelineCfg.Timer.Spec) == 0 {
+		delete(configMap, utils.TerraformResourceTimer)
+	}
+
+	if len(pipelineCfg.TrackingTool.Type) == 0 {
+		delete(configMap, utils.TerraformResourceTrackingTool)
+	}
+
+	// fetch_materials is omitted by gocd.PipelineStageConfig when set to false, which GoCD would otherwise default to true.
+	if stages, ok := configMap[utils.TerraformResourceStages].([]any); ok {
+		for index, stage := range stages {
+			stage.(map[string]any)["fetch_materials"] = pipelineCfg.Stages[index].FetchMaterials
+		}
+	}
+
+	return configMap, nil
+}
+
+func getPipelineMaterials(configs any) ([]gocd.Material, error) {
+	materialConfigs := configs.([]any)
+	materials := make([]gocd.Material, 0, len(materialConfigs))
+
+	for _, materialConfig := range materialConfigs {
+		material, err := getMaterials([]any{materialConfig})
+		if err != nil {
+			return nil, err
+		}
+
+		materials = append(materials, material)
+	}
+
+	return materials, nil
+}
+
+func getPipelineStages(configs any) ([]gocd.PipelineStageConfig, error) {
+	stageConfigs := configs.([]any)
+	stages := make([]gocd.PipelineStageConfig, 0, len(stageConfigs))
+
+	for _, stageConfig := range stageConfigs {
+		stage := stageConfig.(map[string]any)
+
+		jobs, err := getPipelineJobs(stage[utils.TerraformResourceJobs])
+		if err != nil {
+			return nil, fmt.Errorf("stage '%s': %w", stage["name"], err)
+		}
+
+		pipelineStage := gocd.PipelineStageConfig{
+			Name:                  utils.String(stage["name"]),
+			FetchMaterials:        utils.Bool(stage["fetch_materials"]),
+			CleanWorkingDirectory: utils.Bool(stage["clean_working_directory"]),
+			NeverCleanupArtifacts: utils.Bool(stage["never_cleanup_artifacts"]),
+			EnvironmentVariables:  getPipelineEnvironmentVariables(stage[utils.TerraformResourceEnvVar]),
+			Jobs:                  jobs,
+		}
+
+		if approvals := stage["approval"].([]any); len(approvals) != 0 && approvals[0] != nil {
+			approval := approvals[0].(map[string]any)
+			pipelineStage.Approval = gocd.PipelineApprovalConfig{
+				Type:               utils.String(approval["type"]),
+				AllowOnlyOnSuccess: utils.Bool(approval["allow_only_on_success"]),
+				Authorization: gocd.AuthorizationConfig{
+					Users: utils.GetSlice(approval[utils.TerraformResourceUsers].([]any)),
+					Roles: utils.GetSlice(approval[utils.TerraformResourceRoles].([]any)),
+				},
+			}
+		}
+
+		stages = append(stages, pipelineStage)
+	}
+
+	return stages, nil
+}
+
+func getPipelineJobs(configs any) ([]gocd.PipelineJobConfig, error) {
+	jobConfigs := configs.([]any)
+	jobs := make([]gocd.PipelineJobConfig, 0, len(jobConfigs))
+
+	for _, jobConfig := range jobConfigs {
+		job := jobConfig.(map[string]any)
+
+		pipelineJob := gocd.PipelineJobConfig{
+			Name:                 utils.String(job["name"]),
+			ElasticProfileID:     utils.String(job["elastic_profile_id"]),
+			Resources:            utils.GetSlice(job[utils.TerraformResourceResources].([]any)),
+			EnvironmentVariables: getPipelineEnvironmentVariables(job[utils.TerraformResourceEnvVar]),
+			Tasks:                getPipelineTasks(job[utils.TerraformResourceTasks]),
+		}
+
+		if runInstanceCount := utils.String(job["run_instance_count"]); len(runInstanceCount) != 0 {
+			if count, err := strconv.Atoi(runInstanceCount); err == nil {
+				pipelineJob.RunInstanceCount = count
+			} else if runInstanceCount == "all" {
+				pipelineJob.RunInstanceCount = runInstanceCount
+			} else {
+				return nil, fmt.Errorf("job '%s': run_instance_count should either be a number or 'all', got '%s'", pipelineJob.Name, runInstanceCount)
+			}
+		}
+
+		if timeout := job["timeout"].(int); timeout != 0 {
+			pipelineJob.Timeout = timeout
+		}
+
+		for _, tab := range job["tabs"].([]any) {
+			flattenedTab := tab.(map[string]any)
+			pipelineJob.Tabs = append(pipelineJob.Tabs, gocd.PipelineTab{
+				Name: utils.String(flattenedTab["name"]),
+				Path: utils.String(flattenedTab["path"]),
+			})
+		}
+
+		for _, artifact := range job["artifacts"].([]any) {
+			flattenedArtifact := artifact.(map[string]any)
+			pipelineArtifact := gocd.PipelineArtifact{
+				Type:        utils.String(flattenedArtifact["type"]),
+				Source:      utils.String(flattenedArtifact["source"]),
+				Destination: utils.String(flattenedArtifact["destination"]),
+				ArtifactID:  utils.String(flattenedArtifact["id"]),
+				StoreID:     utils.String(flattenedArtifact["store_id"]),
+			}
+
+			for key, value := range flattenedArtifact[utils.TerraformResourceConfiguration].(map[string]any) {
+				pipelineArtifact.Configuration = append(pipelineArtifact.Configuration, map[string]string{
+					utils.TerraformResourceKey:   key,
+					utils.TerraformResourceValue: utils.String(value),
+				})
+			}
+
+			pipelineJob.Artifacts = append(pipelineJob.Artifacts, pipelineArtifact)
+		}
+
+		jobs = append(jobs, pipelineJob)
+	}
+
+	return jobs, nil
+}
+
+func getPipelineTasks(configs any) []gocd.PipelineTaskConfig {
+	taskConfigs := configs.([]any)
+	tasks := make([]gocd.PipelineTaskConfig, 0, len(taskConfigs))
+
+	for _, taskConfig := range taskConfigs {
+		task := taskConfig.(map[string]any)
+		pipelineTask := gocd.PipelineTaskConfig{Type: utils.String(task["type"])}
+
+		if attributes := task["attributes"].([]any); len(attributes) != 0 && attributes[0] != nil {
+			attribute := attributes[0].(map[string]any)
+			pipelineTask.Attributes = gocd.TaskAttributeConfig{
+				Command:          utils.String(attribute["command"]),
+				Arguments:        utils.GetSlice(attribute["arguments"].([]any)),
+				WorkingDirectory: utils.String(attribute["working_directory"]),
+				RunIf:            utils.GetSlice(attribute["run_if"].([]any)),
+				ArtifactOrigin:   utils.String(attribute["artifact_origin"]),
+				Pipeline:         utils.String(attribute["pipeline"]),
+				Stage:            utils.String(attribute["stage"]),
+				Job:              utils.String(attribute["job"]),
+				Source:           utils.String(attribute["source"]),
+				IsSourceAFile:    utils.Bool(attribute["is_source_a_file"]),
+				Destination:      utils.String(attribute["destination"]),
+				ArtifactID:       utils.String(attribute["artifact_id"]),
+				Configuration:    getPluginConfiguration(attribute[utils.TerraformResourceConfiguration]),
+			}
+			pipelineTask.Attributes.PluginConfiguration.ID = utils.String(attribute["plugin_id"])
+			pipelineTask.Attributes.PluginConfiguration.Version = utils.String(attribute["plugin_version"])
+		}
+
+		tasks = append(tasks, pipelineTask)
+	}
+
+	return tasks
+}
+
+func getPipelineEnvironmentVariables(configs any) []gocd.PipelineEnvironmentVariables {
+	envConfigs := configs.(*schema.Set).List()
+	envVars := make([]gocd.PipelineEnvironmentVariables, 0, len(envConfigs))
+
+	for _, envConfig := range envConfigs {
+		envVar := envConfig.(map[string]any)
+		envVars = append(envVars, gocd.PipelineEnvironmentVariables{
+			Name:           utils.String(envVar["name"]),
+			Value:          utils.String(envVar["value"]),
+			EncryptedValue: utils.String(envVar[utils.TerraformResourceENCValue]),
+			Secure:         utils.Bool(envVar["secure"]),
+		})
+	}
+
+	return envVars
+}
+
+func getPipelineParameters(configs any) []gocd.PipelineEnvironmentVariables {
+	paramConfigs := configs.(*schema.Set).List()
+	params := make([]gocd.PipelineEnvironmentVariables, 0, len(paramConfigs))
+
+	for _, paramConfig := range paramConfigs {
+		param := paramConfig.(map[string]any)
+		params = append(params, gocd.PipelineEnvironmentVariables{
+			Name:  utils.String(param["name"]),
+			Value: utils.String(param["value"]),
+		})
+	}
+
+	return params
+}
+
+// setStructuredPipelineConfig sets the blocks of the resource from the pipeline config obtained from GoCD.
+func setStructuredPipelineConfig(d *schema.ResourceData, liveConfig map[string]any) error {
+	var pipelineCfg gocd.PipelineConfig
+
+	out, err := json.Marshal(liveConfig)
+	if err != nil {
+		return err
+	}
+
+	if err = json.Unmarshal(out, &pipelineCfg); err != nil {
+		return err
+	}
+
+	materials := make([]any, 0, len(pipelineCfg.Materials))
+	for _, material := range pipelineCfg.Materials {
+		materials = append(materials, flattenMaterial(material)...)
+	}
+
+	var timer, trackingTool []any
+	if len(pipelineCfg.Timer.Spec) != 0 {
+		timer = []any{map[string]any{
+			"spec":            pipelineCfg.Timer.Spec,
+			"only_on_changes": pipelineCfg.Timer.OnlyOnChanges,
+		}}
+	}
+
+	if len(pipelineCfg.TrackingTool.Type) != 0 {
+		trackingTool = []any{map[string]any{
+			"type":        pipelineCfg.TrackingTool.Type,
+			"url_pattern": pipelineCfg.TrackingTool.Attributes.URLPattern,
+			"regex":       pipelineCfg.TrackingTool.Attributes.Regex,
+		}}
+	}
+
+	params := make([]any, 0, len(pipelineCfg.Parameters))
+	for _, param := range pipelineCfg.Parameters {
+		params = append(params, map[string]any{"name": param.Name, "value": param.Value})
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceLabelTemplate: pipelineCfg.LabelTemplate,
+		utils.TerraformResourceLockBehavior:  pipelineCfg.LockBehavior,
+		utils.TerraformResourceTemplate:      pipelineCfg.Template,
+		utils.TerraformResourceMaterials:     materials,
+		utils.TerraformResourceStages:        flattenPipelineStages(pipelineCfg.Stages, d),
+		utils.TerraformResourceEnvVar: flattenPipelineEnvironmentVariables(pipelineCfg.EnvironmentVariables,
+			d.Get(utils.TerraformResourceEnvVar)),
+		utils.TerraformResourceParameters:   params,
+		utils.TerraformResourceTimer:        timer,
+		utils.TerraformResourceTrackingTool: trackingTool,
+	}
+
+	for _, attribute := range pipelineStructuredAttributes {
+		if err = d.Set(attribute, attributes[attribute]); err != nil {
+			return fmt.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenPipelineStages(stages []gocd.PipelineStageConfig, d *schema.ResourceData) []any {
+	flattenedStages := make([]any, 0, len(stages))
+
+	for stageIndex, stage := range stages {
+		stagePath := fmt.Sprintf("%s.%d", utils.TerraformResourceStages, stageIndex)
+
+		jobs := make([]any, 0, len(stage.Jobs))
+
+		for jobIndex, job := range stage.Jobs {
+			jobPath := fmt.Sprintf("%s.%s.%d", stagePath, utils.TerraformResourceJobs, jobIndex)
+			jobs = append(jobs, flattenPipelineJob(job, d.Get(jobPath+"."+utils.TerraformResourceEnvVar)))
+		}
+
+		flattenedStages = append(flattenedStages, map[string]any{
+			"name":                    stage.Name,
+			"fetch_materials":         stage.FetchMaterials,
+			"clean_working_directory": stage.CleanWorkingDirectory,
+			"never_cleanup_artifacts": stage.NeverCleanupArtifacts,
+			"approval": []any{map[string]any{
+				"type":                       stage.Approval.Type,
+				"allow_only_on_success":      stage.Approval.AllowOnlyOnSuccess,
+				utils.TerraformResourceUsers: stage.Approval.Authorization.Users,
+				utils.TerraformResourceRoles: stage.Approval.Authorization.Roles,
+			}},
+			utils.TerraformResourceEnvVar: flattenPipelineEnvironmentVariables(stage.EnvironmentVariables,
+				d.Get(stagePath+"."+utils.TerraformResourceEnvVar)),
+			utils.TerraformResourceJobs: jobs,
+		})
+	}
+
+	return flattenedStages
+}
+
+func flattenPipelineJob(job gocd.PipelineJobConfig, configuredEnvVars any) map[string]any {
+	tabs := make([]any, 0, len(job.Tabs))
+	for _, tab := range job.Tabs {
+		tabs = append(tabs, map[string]any{"name": tab.Name, "path": tab.Path})
+	}
+
+	artifacts := make([]any, 0, len(job.Artifacts))
+
+	for _, artifact := range job.Artifacts {
+		configuration := make(map[string]any, len(artifact.Configuration))
+		for _, property := range artifact.Configuration {
+			configuration[property[utils.TerraformResourceKey]] = property[utils.TerraformResourceValue]
+		}
+
+		artifacts = append(artifacts, map[string]any{
+			"type":                               artifact.Type,
+			"source":                             artifact.Source,
+			"destination":                        artifact.Destination,
+			"id":                                 artifact.ArtifactID,
+			"store_id":                           artifact.StoreID,
+			utils.TerraformResourceConfiguration: configuration,
+		})
+	}
+
+	tasks := make([]any, 0, len(job.Tasks))
+
+	for _, task := range job.Tasks {
+		configuration := make([]any, 0, len(task.Attributes.Configuration))
+		for _, property := range task.Attributes.Configuration {
+			configuration = append(configuration, map[string]any{
+				utils.TerraformResourceKey:      property.Key,
+				utils.TerraformResourceValue:    property.Value,
+				utils.TerraformResourceENCValue: property.EncryptedValue,
+				utils.TerraformResourceIsSecure: property.IsSecure,
+			})
+		}
+
+		tasks = append(tasks, map[string]any{
+			"type": task.Type,
+			"attributes": []any{map[string]any{
+				"command":                            task.Attributes.Command,
+				"arguments":                          task.Attributes.Arguments,
+				"working_directory":                  task.Attributes.WorkingDirectory,
+				"run_if":                             task.Attributes.RunIf,
+				"artifact_origin":                    task.Attributes.ArtifactOrigin,
+				"pipeline":                           task.Attributes.Pipeline,
+				"stage":                              task.Attributes.Stage,
+				"job":                                task.Attributes.Job,
+				"source":                             task.Attributes.Source,
+				"is_source_a_file":                   task.Attributes.IsSourceAFile,
+				"destination":                        task.Attributes.Destination,
+				"artifact_id":                        task.Attributes.ArtifactID,
+				"plugin_id":                          task.Attributes.PluginConfiguration.ID,
+				"plugin_version":                     task.Attributes.PluginConfiguration.Version,
+				utils.TerraformResourceConfiguration: configuration,
+			}},
+		})
+	}
+
+	var runInstanceCount string
+	if job.RunInstanceCount != nil {
+		runInstanceCount = cast.ToString(job.RunInstanceCount)
+	}
+
+	return map[string]any{
+		"name":                           job.Name,
+		"run_instance_count":             runInstanceCount,
+		"timeout":                        cast.ToInt(job.Timeout),
+		"elastic_profile_id":             job.ElasticProfileID,
+		utils.TerraformResourceResources: job.Resources,
+		utils.TerraformResourceEnvVar:    flattenPipelineEnvironmentVariables(job.EnvironmentVariables, configuredEnvVars),
+		"tabs":                           tabs,
+		"artifacts":                      artifacts,
+		utils.TerraformResourceTasks:     tasks,
+	}
+}
+
+// flattenPipelineEnvironmentVariables flattens the environment variables obtained from GoCD, since GoCD returns only the
+// encrypted value of secure variables, the values configured for them are retained from the state.
+func flattenPipelineEnvironmentVariables(envVars []gocd.PipelineEnvironmentVariables, configured any) []any {
+	configuredVars := make(map[string]map[string]any)
+
+	if configuredSet, ok := configured.(*schema.Set); ok {
+		for _, configuredVar := range configuredSet.List() {
+			envVar := configuredVar.(map[string]any)
+			configuredVars[utils.String(envVar["name"])] = envVar
+		}
+	}
+
+	flattenedEnvVars := make([]any, 0, len(envVars))
+
+	for _, envVar := range envVars {
+		flattenedEnvVar := map[string]any{
+			"name":                          envVar.Name,
+			"value":                         envVar.Value,
+			utils.TerraformResourceENCValue: envVar.EncryptedValue,
+			"secure":                        envVar.Secure,
+		}
+
+		if configuredVar, ok := configuredVars[envVar.Name]; ok && envVar.Secure {
+			flattenedEnvVar["value"] = configuredVar["value"]
+			flattenedEnvVar[utils.TerraformResourceENCValue] = configuredVar[utils.TerraformResourceENCValue]
+		}
+
+		flattenedEnvVars = append(flattenedEnvVars, flattenedEnvVar)
+	}
+
+	return flattenedEnvVars
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func resourcePipelineState() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePipelineStateCreate,
+		ReadContext:   resourcePipelineStateRead,
+		UpdateContext: resourcePipelineStateUpdate,
+		DeleteContext: resourcePipelineStateDelete,
+		CustomizeDiff: resourcePipelineStateCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePipelineStateImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"pipeline": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The name of the pipeline whose state has to be managed.",
+			},
+			"paused": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Default:     false,
+				Description: "Whether the pipeline should be paused, the pipeline is paused/unpaused in place.",
+			},
+			"pause_reason": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         false,
+				DiffSuppressFunc: suppressPauseReasonDiff,
+				Description:      "The reason for pausing the pipeline, used only when `paused` is enabled.",
+			},
+			"unlock": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Default:     false,
+				Description: "Enable to release the lock of the pipeline whenever it is found locked, useful to recover the pipelines stuck in locked state.",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the pipeline is locked.",
+			},
+			"paused_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user who paused the pipeline.",
+			},
+			"schedulable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the pipeline can be scheduled.",
+			},
+		},
+	}
+}
+
+func resourcePipelineStateCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+
+	response, err := defaultConfig.GetPipelineState(pipeline)
+	if err != nil {
+		return diag.Errorf("getting state of pipeline '%s' errored with: %v", pipeline, err)
+	}
+
+	if err = setPipelineState(defaultConfig, d, response); err != nil {
+		return diag.Errorf("setting state of pipeline '%s' errored with: %v", pipeline, err)
+	}
+
+	d.SetId(pipeline)
+
+	return resourcePipelineStateRead(ctx, d, meta)
+}
+
+func resourcePipelineStateRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	response, err := defaultConfig.GetPipelineState(d.Id())
+	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("pipeline '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
+		return diag.Errorf("getting state of pipeline '%s' errored with: %v", d.Id(), err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourcePipeline:    d.Id(),
+		utils.TerraformResourcePaused:      response.Paused,
+		utils.TerraformResourcePauseReason: response.PausedCause,
+		utils.TerraformResourceLocked:      response.Locked,
+		utils.TerraformResourcePausedBy:    response.PausedBy,
+		utils.TerraformResourceSchedulable: response.Schedulable,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return nil
+}
+
+func resourcePipelineStateUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.HasChanges(utils.TerraformResourcePaused, utils.TerraformResourcePauseReason, utils.TerraformResourceLocked) {
+		log.Printf("nothing to update so skipping")
+
+		return nil
+	}
+
+	response, err := defaultConfig.GetPipelineState(d.Id())
+	if err != nil {
+		return diag.Errorf("getting state of pipeline '%s' errored with: %v", d.Id(), err)
+	}
+
+	if err = setPipelineState(defaultConfig, d, response); err != nil {
+		return diag.Errorf("updating state of pipeline '%s' errored with: %v", d.Id(), err)
+	}
+
+	return resourcePipelineStateRead(ctx, d, meta)
+}
+
+func resourcePipelineStateDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	// pipelines are unpaused on destroy, which is the default state of the pipeline.
+	if utils.Bool(d.Get(utils.TerraformResourcePaused)) {
+		if err := defaultConfig.PipelineUnPause(id); err != nil && !gocdclient.IsNotFound(err) {
+			return diag.Errorf("unpausing pipeline '%s' errored with: %v", id, err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePipelineStateImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	defaultConfig := meta.(gocd.GoCd)
+
+	pipeline := utils.String(d.Id())
+
+	if _, err := defaultConfig.GetPipelineState(pipeline); err != nil {
+		return nil, fmt.Errorf("getting state of pipeline %s errored with: %w", pipeline, err)
+	}
+
+	if err := d.Set(utils.TerraformResourcePipeline, pipeline); err != nil {
+		return nil, fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourcePipeline, err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourcePipelineStateCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if len(d.Id()) == 0 {
+		return nil
+	}
+
+	// a locked pipeline is planned to be unlocked when unlock is enabled, which is done in place.
+	if utils.Bool(d.Get(utils.TerraformResourceUnlock)) && utils.Bool(d.Get(utils.TerraformResourceLocked)) {
+		return d.SetNew(utils.TerraformResourceLocked, false)
+	}
+
+	return nil
+}
+
+// setPipelineState pauses/unpauses and unlocks the pipeline as per the config, when its live state differs from it.
+func setPipelineState(defaultConfig gocd.GoCd, d *schema.ResourceData, live gocd.PipelineState) error {
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+	paused := utils.Bool(d.Get(utils.TerraformResourcePaused))
+	pauseReason := utils.String(d.Get(utils.TerraformResourcePauseReason))
+
+	if live.Paused && (!paused || live.PausedCause != pauseReason) {
+		if err := defaultConfig.PipelineUnPause(pipeline); err != nil {
+			return fmt.Errorf("unpausing pipeline errored with: %w", err)
+		}
+
+		live.Paused = false
+	}
+
+	if paused && !live.Paused {
+		if err := defaultConfig.PipelinePause(pipeline, pauseReason); err != nil {
+			return fmt.Errorf("pausing pipeline errored with: %w", err)
+		}
+	}
+
+	if utils.Bool(d.Get(utils.TerraformResourceUnlock)) && live.Locked {
+		if err := defaultConfig.PipelineUnlock(pipeline); err != nil {
+			return fmt.Errorf("unlocking pipeline errored with: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func suppressPauseReasonDiff(_, _, _ string, d *schema.ResourceData) bool {
+	return !utils.Bool(d.Get(utils.TerraformResourcePaused))
+}
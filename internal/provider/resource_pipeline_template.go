@@ -28,11 +28,13 @@ func resourcePipelineTemplate() *schema.Resource {
 				Description: "The name of the pipeline template to be created or updated.",
 			},
 			"config": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Computed:    false,
-				ForceNew:    false,
-				Description: "The pipeline template config to be created or updated. It can be YAML or JSON based on the `yaml` attribute.",
+				Type:             schema.TypeString,
+				Required:         true,
+				Computed:         false,
+				ForceNew:         false,
+				DiffSuppressFunc: suppressEquivalentConfigDiff,
+				Description: "The pipeline template config to be created or updated. It can be YAML or JSON based on the `yaml` attribute. " +
+					"Changes in key order, indentation, format or fields set to GoCD defaults are not treated as a diff.",
 			},
 			"yaml": {
 				Type:        schema.TypeBool,
@@ -100,6 +102,13 @@ func resourcePipelineTemplateRead(_ context.Context, d *schema.ResourceData, met
 
 	response, err := defaultConfig.GetTemplate(name)
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("pipeline template '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("getting pipeline template '%s' errored with: %v", name, err)
 	}
 
@@ -0,0 +1,181 @@
+//nolint:testpackage
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func TestPipelineConfigChangedIgnoresFormatAndServerFields(t *testing.T) {
+	liveConfig := normalizePipelineConfig(map[string]any{
+		"_links": map[string]any{"self": map[string]any{"href": "https://gocd.com/go/api/admin/pipelines/helm-drift"}},
+		"name":   "helm-drift",
+		"group":  "sample-group",
+		"origin": map[string]any{"type": "gocd"},
+		"stages": []any{
+			map[string]any{
+				"name": "lint",
+				"jobs": []any{
+					map[string]any{"name": "lint", "timeout": float64(10)},
+				},
+			},
+		},
+	})
+
+	yamlConfig := `
+name: helm-drift
+stages:
+  - name: lint
+    jobs:
+      - name: lint
+        timeout: 10
+`
+
+	changed, err := pipelineConfigChanged(yamlConfig, liveConfig)
+	if err != nil {
+		t.Fatalf("unexpected error comparing yaml pipeline config: %v", err)
+	}
+
+	if changed {
+		t.Fatal("yaml pipeline config should be treated same as the config obtained from GoCD")
+	}
+
+	jsonConfig := `{"name": "helm-drift", "stages": [{"name": "lint", "jobs": [{"name": "lint", "timeout": 10}]}]}`
+
+	changed, err = pipelineConfigChanged(jsonConfig, liveConfig)
+	if err != nil {
+		t.Fatalf("unexpected error comparing json pipeline config: %v", err)
+	}
+
+	if changed {
+		t.Fatal("json pipeline config should be treated same as the config obtained from GoCD")
+	}
+
+	driftedConfig := `{"name": "helm-drift", "stages": [{"name": "lint", "jobs": [{"name": "lint", "timeout": 20}]}]}`
+
+	changed, err = pipelineConfigChanged(driftedConfig, liveConfig)
+	if err != nil {
+		t.Fatalf("unexpected error comparing drifted pipeline config: %v", err)
+	}
+
+	if !changed {
+		t.Fatal("changes made to pipeline outside terraform should be detected")
+	}
+}
+
+func TestStructuredPipelineConfig(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePipeline().Schema, map[string]any{
+		"name":  "helm-drift",
+		"group": "sample-group",
+		"materials": []any{
+			map[string]any{
+				"type": "git",
+				"attributes": []any{
+					map[string]any{"url": "https://github.com/nikhilsbhat/helm-drift.git", "branch": "master"},
+				},
+			},
+		},
+		"stages": []any{
+			map[string]any{
+				"name":            "lint",
+				"fetch_materials": false,
+				"jobs": []any{
+					map[string]any{
+						"name":               "lint",
+						"run_instance_count": "all",
+						"tasks": []any{
+							map[string]any{
+								"type":       "exec",
+								"attributes": []any{map[string]any{"command": "make", "arguments": []any{"lint"}}},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	pipelineCfg, err := getStructuredPipelineConfig(d)
+	if err != nil {
+		t.Fatalf("unexpected error building pipeline config: %v", err)
+	}
+
+	configMap, err := getPipelineConfigMap(pipelineCfg)
+	if err != nil {
+		t.Fatalf("unexpected error translating pipeline config: %v", err)
+	}
+
+	if _, ok := configMap["timer"]; ok {
+		t.Fatal("timer should not be part of the pipeline config when not set")
+	}
+
+	stage := configMap["stages"].([]any)[0].(map[string]any)
+	if stage["fetch_materials"] != false {
+		t.Fatalf("fetch_materials set to false should be retained, got %v", stage["fetch_materials"])
+	}
+
+	if err = setStructuredPipelineConfig(d, configMap); err != nil {
+		t.Fatalf("unexpected error setting pipeline config: %v", err)
+	}
+
+	if got := d.Get("stages.0.jobs.0.run_instance_count"); got != "all" {
+		t.Fatalf("expected run_instance_count to be 'all', got %v", got)
+	}
+
+	if got := d.Get("stages.0.jobs.0.tasks.0.attributes.0.command"); got != "make" {
+		t.Fatalf("expected task command to be 'make', got %v", got)
+	}
+
+	if got := d.Get("materials.0.attributes.0.url"); got != "https://github.com/nikhilsbhat/helm-drift.git" {
+		t.Fatalf("expected material url to be retained, got %v", got)
+	}
+}
+
+func TestStructuredPipelineAttributesConflictWithConfig(t *testing.T) {
+	for _, attribute := range pipelineStructuredAttributes {
+		if conflicts := resourcePipeline().Schema[attribute].ConflictsWith; !slices.Contains(conflicts, utils.TerraformResourceConfig) {
+			t.Errorf("expected '%s' to conflict with config, got conflicts: %v", attribute, conflicts)
+		}
+	}
+
+	diags := resourcePipeline().Validate(terraform.NewResourceConfigRaw(map[string]any{
+		"name":           "helm-drift",
+		"group":          "sample-group",
+		"config":         "name: helm-drift",
+		"label_template": "${COUNT}",
+	}))
+	if !diags.HasError() {
+		t.Fatal("expected label_template set along with config to fail the validation")
+	}
+}
+
+func TestParsePipelineImportID(t *testing.T) {
+	tests := map[string]struct {
+		id      string
+		name    string
+		isYAML  bool
+		wantErr bool
+	}{
+		"name only":          {id: "helm-drift", name: "helm-drift"},
+		"name with yaml":     {id: "helm-drift:yaml", name: "helm-drift", isYAML: true},
+		"name with json":     {id: "helm-drift:json", name: "helm-drift"},
+		"unsupported format": {id: "helm-drift:toml", wantErr: true},
+	}
+
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			name, isYAML, err := parsePipelineImportID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error to be %t, got: %v", tt.wantErr, err)
+			}
+
+			if name != tt.name || isYAML != tt.isYAML {
+				t.Errorf("expected name '%s' and yaml %t, got name '%s' and yaml %t", tt.name, tt.isYAML, name, isYAML)
+			}
+		})
+	}
+}
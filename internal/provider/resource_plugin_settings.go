@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -114,6 +115,13 @@ func resourcePluginsSettingsRead(_ context.Context, d *schema.ResourceData, meta
 
 	response, err := defaultConfig.GetPluginSettings(utils.String(d.Get(utils.TerraformResourcePluginID)))
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("plugin settings '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("getting plugin configuration errored with: %v", err)
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -138,6 +139,13 @@ func resourceRoleRead(_ context.Context, d *schema.ResourceData, meta any) diag.
 
 	response, err := defaultConfig.GetRole(name)
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("role '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("fetching role %s errored with: %v", name, err)
 	}
 
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const defaultSCMPluginVersion = "1"
+
+func resourceSCM() *schema.Resource {
+	configuration := propertiesSchemaResource()
+	configuration.Description = "The list of configuration properties that represent the configuration of the SCM."
+
+	return &schema.Resource{
+		CreateContext: resourceSCMCreate,
+		ReadContext:   resourceSCMRead,
+		UpdateContext: resourceSCMUpdate,
+		DeleteContext: resourceSCMDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSCMImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The name of the SCM, GoCD identifies the SCM by its name.",
+			},
+			"scm_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The identifier of the SCM to be referred in the pipeline materials, GoCD generates one when not set.",
+			},
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The identifier of the SCM plugin used by the SCM.",
+			},
+			"plugin_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    false,
+				Default:     defaultSCMPluginVersion,
+				Description: "The version of the SCM plugin used by the SCM.",
+			},
+			"auto_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    false,
+				Default:     true,
+				Description: "Whether GoCD should poll the SCM for new changes.",
+			},
+			"configuration": configuration,
+			"etag": {
+				Type:        schema.TypeString,
+				Required:    false,
+				Computed:    true,
+				ForceNew:    false,
+				Description: "Etag used to track the SCM.",
+			},
+		},
+	}
+}
+
+func resourceSCMCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	scmClient := meta.(gocdclient.SCMClient)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		resourceID := utils.String(d.Get(utils.TerraformResourceName))
+		id = resourceID
+	}
+
+	if _, err := scmClient.CreateSCM(getSCM(d)); err != nil {
+		return diag.Errorf("creating scm '%s' errored with %v", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceSCMRead(ctx, d, meta)
+}
+
+func resourceSCMRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	scmClient := meta.(gocdclient.SCMClient)
+
+	response, err := scmClient.GetSCM(d.Id())
+	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("scm '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
+		return diag.Errorf("getting scm '%s' errored with: %v", d.Id(), err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceName:          response.Name,
+		utils.TerraformResourceSCMID:         response.ID,
+		utils.TerraformResourcePluginID:      response.PluginMetadata[pluginMetadataID],
+		utils.TerraformResourcePluginVersion: response.PluginMetadata[pluginMetadataVersion],
+		utils.TerraformResourceAutoUpdate:    response.AutoUpdate,
+		utils.TerraformResourceEtag:          response.ETAG,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceSCMUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	scmClient := meta.(gocdclient.SCMClient)
+
+	if !d.HasChanges(utils.TerraformResourcePluginVersion, utils.TerraformResourceAutoUpdate, utils.TerraformResourceConfiguration) {
+		log.Printf("nothing to update so skipping")
+
+		return nil
+	}
+
+	cfg := getSCM(d)
+	cfg.ETAG = utils.String(d.Get(utils.TerraformResourceEtag))
+
+	if _, err := scmClient.UpdateSCM(cfg); err != nil {
+		return diag.Errorf("updating scm '%s' errored with: %v", cfg.Name, err)
+	}
+
+	return resourceSCMRead(ctx, d, meta)
+}
+
+func resourceSCMDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	scmClient := meta.(gocdclient.SCMClient)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	if err := scmClient.DeleteSCM(id); err != nil {
+		return diag.Errorf("deleting scm '%s' errored with: %v", id, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSCMImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	scmClient := meta.(gocdclient.SCMClient)
+
+	name := utils.String(d.Id())
+
+	response, err := scmClient.GetSCM(name)
+	if err != nil {
+		return nil, fmt.Errorf("getting scm %s errored with: %w", name, err)
+	}
+
+	flattenedConfiguration, err := utils.MapSlice(response.Configuration)
+	if err != nil {
+		return nil, fmt.Errorf("errored while flattening scm configuration obtained: %w", err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceName:          response.Name,
+		utils.TerraformResourceSCMID:         response.ID,
+		utils.TerraformResourcePluginID:      response.PluginMetadata[pluginMetadataID],
+		utils.TerraformResourcePluginVersion: response.PluginMetadata[pluginMetadataVersion],
+		utils.TerraformResourceAutoUpdate:    response.AutoUpdate,
+		utils.TerraformResourceConfiguration: flattenedConfiguration,
+		utils.TerraformResourceEtag:          response.ETAG,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return nil, fmt.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getSCM(d *schema.ResourceData) gocdclient.SCM {
+	return gocdclient.SCM{
+		ID:         utils.String(d.Get(utils.TerraformResourceSCMID)),
+		Name:       utils.String(d.Get(utils.TerraformResourceName)),
+		AutoUpdate: utils.Bool(d.Get(utils.TerraformResourceAutoUpdate)),
+		PluginMetadata: map[string]string{
+			pluginMetadataID:      utils.String(d.Get(utils.TerraformResourcePluginID)),
+			pluginMetadataVersion: utils.String(d.Get(utils.TerraformResourcePluginVersion)),
+		},
+		Configuration: getPluginConfiguration(d.Get(utils.TerraformResourceConfiguration)),
+	}
+}
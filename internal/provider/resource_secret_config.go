@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -78,6 +79,10 @@ func resourceSecretConfigCreate(ctx context.Context, data *schema.ResourceData,
 		return nil
 	}
 
+	if diags := requireServerVersion(meta, "secret configs", gocdclient.MinSecretConfigVersion); diags != nil {
+		return diags
+	}
+
 	id := data.Id()
 
 	if len(id) == 0 {
@@ -156,6 +161,13 @@ func resourceSecretConfigRead(_ context.Context, data *schema.ResourceData, meta
 
 	response, err := defaultConfig.GetSecretConfig(profileID)
 	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("secret config '%s' not found in GoCD, removing it from the state", data.Id())
+			data.SetId("")
+
+			return nil
+		}
+
 		return diag.Errorf("getting secret config %s errored with: %v", profileID, err)
 	}
 
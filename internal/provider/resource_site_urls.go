@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const siteURLsID = "site_urls"
+
+func resourceSiteURLs() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSiteURLsCreate,
+		ReadContext:   resourceSiteURLsRead,
+		UpdateContext: resourceSiteURLsUpdate,
+		DeleteContext: resourceSiteURLsDelete,
+		Schema: map[string]*schema.Schema{
+			"site_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     false,
+				AtLeastOneOf: []string{utils.TerraformResourceSiteURL, utils.TerraformResourceSecureSiteURL},
+				Description:  "The site URL used by GoCD to generate links in emails, feeds etc. Format: [protocol]://[host]:[port].",
+			},
+			"secure_site_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     false,
+				AtLeastOneOf: []string{utils.TerraformResourceSiteURL, utils.TerraformResourceSecureSiteURL},
+				Description:  "The secure site URL, used when GoCD has to redirect to a secure connection. Format: https://[host]:[port].",
+			},
+		},
+	}
+}
+
+func resourceSiteURLsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	cfg := gocd.SiteURLConfig{
+		SiteURL:       utils.String(d.Get(utils.TerraformResourceSiteURL)),
+		SecureSiteURL: utils.String(d.Get(utils.TerraformResourceSecureSiteURL)),
+	}
+
+	if _, err := defaultConfig.CreateOrUpdateSiteURL(cfg); err != nil {
+		return diag.Errorf("creating site urls errored with %v", err)
+	}
+
+	d.SetId(siteURLsID)
+
+	return resourceSiteURLsRead(ctx, d, meta)
+}
+
+func resourceSiteURLsRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	response, err := defaultConfig.GetSiteURL()
+	if err != nil {
+		return diag.Errorf("getting site urls errored with: %v", err)
+	}
+
+	if err = d.Set(utils.TerraformResourceSiteURL, response.SiteURL); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceSiteURL, err)
+	}
+
+	if err = d.Set(utils.TerraformResourceSecureSiteURL, response.SecureSiteURL); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceSecureSiteURL, err)
+	}
+
+	return nil
+}
+
+func resourceSiteURLsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.HasChanges(utils.TerraformResourceSiteURL, utils.TerraformResourceSecureSiteURL) {
+		log.Printf("nothing to update so skipping")
+
+		return nil
+	}
+
+	cfg := gocd.SiteURLConfig{
+		SiteURL:       utils.String(d.Get(utils.TerraformResourceSiteURL)),
+		SecureSiteURL: utils.String(d.Get(utils.TerraformResourceSecureSiteURL)),
+	}
+
+	if _, err := defaultConfig.CreateOrUpdateSiteURL(cfg); err != nil {
+		return diag.Errorf("updating site urls errored with %v", err)
+	}
+
+	return resourceSiteURLsRead(ctx, d, meta)
+}
+
+func resourceSiteURLsDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	// GoCD does not support deleting the site urls, hence they are reset by updating them with empty values.
+	if _, err := defaultConfig.CreateOrUpdateSiteURL(gocd.SiteURLConfig{}); err != nil {
+		return diag.Errorf("resetting site urls errored with: %v", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func resourceSystemAdminMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSystemAdminMemberCreate,
+		ReadContext:   resourceSystemAdminMemberRead,
+		DeleteContext: resourceSystemAdminMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSystemAdminMemberImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     false,
+				ForceNew:     true,
+				ExactlyOneOf: []string{utils.TerraformResourceUser, utils.TerraformResourceRole},
+				Description:  "The user to be added to the system admins.",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     false,
+				ForceNew:     true,
+				ExactlyOneOf: []string{utils.TerraformResourceUser, utils.TerraformResourceRole},
+				Description:  "The role to be added to the system admins.",
+			},
+		},
+	}
+}
+
+func resourceSystemAdminMemberCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	memberType, member := getSystemAdminMember(d)
+
+	if _, err := defaultConfig.UpdateSystemAdminsBulk(getSystemAdminMemberOperations(memberType, member, false)); err != nil {
+		return diag.Errorf("adding %s '%s' to system admins errored with %v", memberType, member, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", memberType, member))
+
+	return resourceSystemAdminMemberRead(ctx, d, meta)
+}
+
+func resourceSystemAdminMemberRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	response, err := defaultConfig.GetSystemAdmins()
+	if err != nil {
+		return diag.Errorf("getting system admins errored with: %v", err)
+	}
+
+	memberType, member := getSystemAdminMember(d)
+
+	members := response.Users
+	if memberType == utils.TerraformResourceRole {
+		members = response.Roles
+	}
+
+	if !slices.Contains(members, member) {
+		log.Printf("%s '%s' is not part of the system admins in GoCD, removing it from the state", memberType, member)
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceSystemAdminMemberDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	memberType, member := getSystemAdminMember(d)
+
+	response, err := defaultConfig.GetSystemAdmins()
+	if err != nil {
+		return diag.Errorf("getting system admins errored with: %v", err)
+	}
+
+	// removing the last member empties the system admins, which makes every user a system admin in GoCD.
+	if len(response.Users)+len(response.Roles) == 1 {
+		log.Printf("%s '%s' is the only system admin, hence it is left as is and removed only from the state", memberType, member)
+		d.SetId("")
+
+		return nil
+	}
+
+	if _, err = defaultConfig.UpdateSystemAdminsBulk(getSystemAdminMemberOperations(memberType, member, true)); err != nil {
+		return diag.Errorf("removing %s '%s' from system admins errored with: %v", memberType, member, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceSystemAdminMemberImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	memberType, member, found := strings.Cut(d.Id(), ":")
+	if !found || (memberType != utils.TerraformResourceUser && memberType != utils.TerraformResourceRole) || len(member) == 0 {
+		return nil, fmt.Errorf("invalid ID '%s', expected 'user:<login_name>' or 'role:<role_name>'", d.Id())
+	}
+
+	if err := d.Set(memberType, member); err != nil {
+		return nil, fmt.Errorf(settingAttrErrorTmp, memberType, err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getSystemAdminMember(d *schema.ResourceData) (string, string) {
+	if role := utils.String(d.Get(utils.TerraformResourceRole)); len(role) != 0 {
+		return utils.TerraformResourceRole, role
+	}
+
+	return utils.TerraformResourceUser, utils.String(d.Get(utils.TerraformResourceUser))
+}
+
+func getSystemAdminMemberOperations(memberType, member string, remove bool) gocd.Operations {
+	members := gocd.AddRemoves{Add: []string{member}}
+	if remove {
+		members = gocd.AddRemoves{Remove: []string{member}}
+	}
+
+	if memberType == utils.TerraformResourceRole {
+		return gocd.Operations{Roles: members}
+	}
+
+	return gocd.Operations{Users: members}
+}
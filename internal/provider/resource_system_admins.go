@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const systemAdminsID = "system_admins"
+
+func resourceSystemAdmins() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSystemAdminsCreate,
+		ReadContext:   resourceSystemAdminsRead,
+		UpdateContext: resourceSystemAdminsUpdate,
+		DeleteContext: resourceSystemAdminsDelete,
+		Schema: map[string]*schema.Schema{
+			"users": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     false,
+				MinItems:     1,
+				AtLeastOneOf: []string{utils.TerraformResourceUsers, utils.TerraformResourceRoles},
+				Description:  "The complete list of users who should be the system admins, users not listed here would be removed from the system admins.",
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"roles": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     false,
+				MinItems:     1,
+				AtLeastOneOf: []string{utils.TerraformResourceUsers, utils.TerraformResourceRoles},
+				Description:  "The complete list of roles whose users should be the system admins, roles not listed here would be removed from the system admins.",
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Required:    false,
+				Computed:    true,
+				ForceNew:    false,
+				Description: "Etag used to track the system admins.",
+			},
+		},
+	}
+}
+
+func resourceSystemAdminsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	adminClient := meta.(gocdclient.SystemAdminClient)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	// system admins always exists in GoCD, the latest etag is required to replace it.
+	response, err := adminClient.GetSystemAdminsRaw()
+	if err != nil {
+		return diag.Errorf("getting system admins errored with: %v", err)
+	}
+
+	cfg := getSystemAdmins(d)
+	cfg.ETAG = response.ETAG
+
+	if _, err = adminClient.UpdateSystemAdminsRaw(cfg); err != nil {
+		return diag.Errorf("creating system admins errored with %v", err)
+	}
+
+	d.SetId(systemAdminsID)
+
+	return resourceSystemAdminsRead(ctx, d, meta)
+}
+
+func resourceSystemAdminsRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	adminClient := meta.(gocdclient.SystemAdminClient)
+
+	response, err := adminClient.GetSystemAdminsRaw()
+	if err != nil {
+		return diag.Errorf("getting system admins errored with: %v", err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceUsers: response.Users,
+		utils.TerraformResourceRoles: response.Roles,
+		utils.TerraformResourceEtag:  response.ETAG,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceSystemAdminsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	adminClient := meta.(gocdclient.SystemAdminClient)
+
+	if !d.HasChanges(utils.TerraformResourceUsers, utils.TerraformResourceRoles) {
+		log.Printf("nothing to update so skipping")
+
+		return nil
+	}
+
+	cfg := getSystemAdmins(d)
+	cfg.ETAG = utils.String(d.Get(utils.TerraformResourceEtag))
+
+	if _, err := adminClient.UpdateSystemAdminsRaw(cfg); err != nil {
+		return diag.Errorf("updating system admins errored with: %v", err)
+	}
+
+	return resourceSystemAdminsRead(ctx, d, meta)
+}
+
+func resourceSystemAdminsDelete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	// system admins cannot be deleted from GoCD, and an empty list would make every user a system admin.
+	// Hence, the system admins are left as is and only removed from the state.
+	log.Printf("system admins cannot be deleted from GoCD, removing it only from the state")
+
+	d.SetId("")
+
+	return nil
+}
+
+func getSystemAdmins(d *schema.ResourceData) gocd.SystemAdmins {
+	return gocd.SystemAdmins{
+		Users: utils.GetSlice(d.Get(utils.TerraformResourceUsers).(*schema.Set).List()),
+		Roles: utils.GetSlice(d.Get(utils.TerraformResourceRoles).(*schema.Set).List()),
+	}
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func resourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"login_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The login name of the user, this should be the one known to the authorization plugin.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The display name of the user, as determined by GoCD.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    false,
+				Description: "The email address of the user.",
+			},
+			"email_me": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    false,
+				Description: "Enable if the user should receive email notifications.",
+			},
+			"checkin_aliases": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    false,
+				Description: "The list of aliases the user uses in the commits, used to match the user for email notifications.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    false,
+				Description: "Whether the user is enabled or not, disabled users cannot login to GoCD.",
+			},
+			"is_admin": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the user is a system admin or not.",
+			},
+		},
+	}
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	id := d.Id()
+
+	if len(id) == 0 {
+		resourceID := utils.String(d.Get(utils.TerraformResourceLoginName))
+		id = resourceID
+	}
+
+	cfg := gocd.User{
+		LoginName:    id,
+		EmailID:      utils.String(d.Get(utils.TerraformResourceEmail)),
+		EmailMe:      utils.Bool(d.Get(utils.TerraformResourceEmailMe)),
+		Enabled:      utils.Bool(d.Get(utils.TerraformResourceEnabled)),
+		CheckInAlias: utils.GetSlice(d.Get(utils.TerraformResourceCheckinAliases).(*schema.Set).List()),
+	}
+
+	if _, err := defaultConfig.CreateUser(cfg); err != nil {
+		return diag.Errorf("creating user '%s' errored with: %v", id, err)
+	}
+
+	d.SetId(id)
+
+	// users are enabled by GoCD on creation, and enabled set to false is dropped from the create request.
+	if !cfg.Enabled {
+		if _, err := meta.(gocdclient.UserClient).UpdateUserRaw(id, map[string]any{utils.TerraformResourceEnabled: false}); err != nil {
+			return diag.Errorf("disabling user '%s' errored with: %v", id, err)
+		}
+	}
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserRead(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	response, err := defaultConfig.GetUser(d.Id())
+	if err != nil {
+		if gocdclient.IsNotFound(err) {
+			log.Printf("user '%s' not found in GoCD, removing it from the state", d.Id())
+			d.SetId("")
+
+			return nil
+		}
+
+		return diag.Errorf("getting user '%s' errored with: %v", d.Id(), err)
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceLoginName:      response.LoginName,
+		utils.TerraformResourceDisplayName:    response.Name,
+		utils.TerraformResourceEmail:          response.EmailID,
+		utils.TerraformResourceEmailMe:        response.EmailMe,
+		utils.TerraformResourceCheckinAliases: response.CheckInAlias,
+		utils.TerraformResourceEnabled:        response.Enabled,
+		utils.TerraformResourceIsAdmin:        response.Admin,
+	}
+
+	for attribute, value := range attributes {
+		if err = d.Set(attribute, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, attribute, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	userClient := meta.(gocdclient.UserClient)
+
+	if !d.HasChanges(utils.TerraformResourceEmail, utils.TerraformResourceEmailMe, utils.TerraformResourceCheckinAliases,
+		utils.TerraformResourceEnabled) {
+		log.Printf("nothing to update so skipping")
+
+		return nil
+	}
+
+	attributes := map[string]any{
+		utils.TerraformResourceEmail:          utils.String(d.Get(utils.TerraformResourceEmail)),
+		utils.TerraformResourceEmailMe:        utils.Bool(d.Get(utils.TerraformResourceEmailMe)),
+		utils.TerraformResourceCheckinAliases: utils.GetSlice(d.Get(utils.TerraformResourceCheckinAliases).(*schema.Set).List()),
+		utils.TerraformResourceEnabled:        utils.Bool(d.Get(utils.TerraformResourceEnabled)),
+	}
+
+	if _, err := userClient.UpdateUserRaw(d.Id(), attributes); err != nil {
+		return diag.Errorf("updating user '%s' errored with: %v", d.Id(), err)
+	}
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	defaultConfig := meta.(gocd.GoCd)
+
+	id := d.Id()
+	if len(d.Id()) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	// GoCD allows deleting only the disabled users.
+	if utils.Bool(d.Get(utils.TerraformResourceEnabled)) {
+		if _, err := meta.(gocdclient.UserClient).UpdateUserRaw(id, map[string]any{utils.TerraformResourceEnabled: false}); err != nil {
+			return diag.Errorf("disabling user '%s' errored with: %v", id, err)
+		}
+	}
+
+	if err := defaultConfig.DeleteUser(id); err != nil {
+		return diag.Errorf("deleting user '%s' errored with: %v", id, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceUserImport(_ context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	defaultConfig := meta.(gocd.GoCd)
+
+	loginName := utils.String(d.Id())
+
+	response, err := defaultConfig.GetUser(loginName)
+	if err != nil {
+		return nil, fmt.Errorf("getting user %s errored with: %w", loginName, err)
+	}
+
+	if err = d.Set(utils.TerraformResourceLoginName, response.LoginName); err != nil {
+		return nil, fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourceLoginName, err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	gocdclient "github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+)
+
+// requireServerVersion fails with a clear diagnostic when the feature is not supported by the version of the GoCD server,
+// instead of the error GoCD would respond with for an unknown API.
+func requireServerVersion(meta any, feature, minimum string) diag.Diagnostics {
+	versionClient, ok := meta.(gocdclient.ServerVersionClient)
+	if !ok {
+		return nil
+	}
+
+	if err := versionClient.RequireServerVersion(feature, minimum); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return nil
+}
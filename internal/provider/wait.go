@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// waitUntil invokes check every delay until it reports done, errors, the timeout elapses or the context is cancelled.
+// The timeout is bounded by the deadline of the context, which is set from the timeouts of the resource.
+func waitUntil(ctx context.Context, timeout, delay time.Duration, check func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+		timeout = time.Until(deadline).Round(time.Second)
+	}
+
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		if time.Now().Add(delay).After(deadline) {
+			return fmt.Errorf("timed out after %s", timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
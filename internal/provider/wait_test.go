@@ -0,0 +1,46 @@
+//nolint:testpackage
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitUntil(t *testing.T) {
+	t.Run("times out at the deadline of the context when it is sooner than the timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		started := time.Now()
+
+		err := waitUntil(ctx, time.Hour, 10*time.Millisecond, func() (bool, error) {
+			return false, nil
+		})
+		if err == nil || !strings.Contains(err.Error(), "timed out after") {
+			t.Fatalf("expected the wait to time out, got: %v", err)
+		}
+
+		if elapsed := time.Since(started); elapsed > time.Second {
+			t.Fatalf("expected the wait to stop at the deadline of the context, it took %s", elapsed)
+		}
+	})
+
+	t.Run("returns once the check is done", func(t *testing.T) {
+		checks := 0
+
+		err := waitUntil(context.Background(), time.Second, time.Millisecond, func() (bool, error) {
+			checks++
+
+			return checks == 3, nil
+		})
+		if err != nil {
+			t.Fatalf("expected the wait to complete, got: %v", err)
+		}
+
+		if checks != 3 {
+			t.Fatalf("expected 3 checks, got %d", checks)
+		}
+	})
+}
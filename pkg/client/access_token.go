@@ -0,0 +1,103 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+const (
+	// AccessTokensEndpoint is the endpoint to manage the access tokens of the current user, not supported by gocd-sdk-go.
+	AccessTokensEndpoint = "/api/current_user/access_tokens"
+	// MinAccessTokenVersion is the oldest GoCD version supporting the access tokens.
+	MinAccessTokenVersion = "19.2.0"
+)
+
+// AccessToken holds information of the access token of a user in GoCD.
+type AccessToken struct {
+	ID          int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Username    string `json:"username,omitempty" yaml:"username,omitempty"`
+	Token       string `json:"token,omitempty" yaml:"token,omitempty"`
+	Revoked     bool   `json:"revoked,omitempty" yaml:"revoked,omitempty"`
+	RevokedBy   string `json:"revoked_by,omitempty" yaml:"revoked_by,omitempty"`
+	RevokedAt   string `json:"revoked_at,omitempty" yaml:"revoked_at,omitempty"`
+	RevokeCause string `json:"revoke_cause,omitempty" yaml:"revoke_cause,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	LastUsedAt  string `json:"last_used_at,omitempty" yaml:"last_used_at,omitempty"`
+}
+
+type AccessTokenClient interface {
+	CreateAccessToken(description string) (AccessToken, error)
+	GetAccessToken(id string) (AccessToken, error)
+	RevokeAccessToken(id, cause string) (AccessToken, error)
+}
+
+// CreateAccessToken creates an access token for the current user, the token is available only in the response of this call.
+func (client *GoCDClient) CreateAccessToken(description string) (AccessToken, error) {
+	if err := client.RequireServerVersion("access tokens", MinAccessTokenVersion); err != nil {
+		return AccessToken{}, err
+	}
+
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept":       gocd.HeaderVersionOne,
+			"Content-Type": gocd.ContentJSON,
+		}).
+		SetBody(map[string]string{"description": description}).
+		Post(AccessTokensEndpoint)
+	if err != nil {
+		return AccessToken{}, fmt.Errorf("create access token '%s': %w", description, err)
+	}
+
+	return decodeAccessTokenResponse(resp)
+}
+
+// GetAccessToken fetches the access token of the current user identified by id.
+func (client *GoCDClient) GetAccessToken(id string) (AccessToken, error) {
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept": gocd.HeaderVersionOne,
+		}).
+		Get(filepath.Join(AccessTokensEndpoint, id))
+	if err != nil {
+		return AccessToken{}, fmt.Errorf("get access token '%s': %w", id, err)
+	}
+
+	return decodeAccessTokenResponse(resp)
+}
+
+// RevokeAccessToken revokes the access token of the current user identified by id.
+func (client *GoCDClient) RevokeAccessToken(id, cause string) (AccessToken, error) {
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept":       gocd.HeaderVersionOne,
+			"Content-Type": gocd.ContentJSON,
+		}).
+		SetBody(map[string]string{"revoke_cause": cause}).
+		Post(filepath.Join(AccessTokensEndpoint, id, "revoke"))
+	if err != nil {
+		return AccessToken{}, fmt.Errorf("revoke access token '%s': %w", id, err)
+	}
+
+	return decodeAccessTokenResponse(resp)
+}
+
+func decodeAccessTokenResponse(resp *resty.Response) (AccessToken, error) {
+	var accessToken AccessToken
+
+	if resp.StatusCode() != http.StatusOK {
+		return accessToken, &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
+	}
+
+	if err := json.Unmarshal(resp.Body(), &accessToken); err != nil {
+		return accessToken, fmt.Errorf("decode access token response: %w", err)
+	}
+
+	return accessToken, nil
+}
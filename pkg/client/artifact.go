@@ -0,0 +1,78 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+const (
+	artifactFilesEndpoint = "/files"
+	// MinArtifactVersion is the oldest GoCD version the artifact files are fetched from with this client.
+	MinArtifactVersion = "19.1.0"
+	// maxArtifactErrorSize bounds the body read from GoCD to report why the artifact could not be fetched.
+	maxArtifactErrorSize = 4096
+)
+
+// ArtifactLocator identifies an artifact file of the job instance.
+type ArtifactLocator struct {
+	Pipeline        string
+	PipelineCounter int
+	Stage           string
+	StageCounter    string
+	Job             string
+	Path            string
+}
+
+type ArtifactClient interface {
+	GetArtifactRaw(locator ArtifactLocator, maxSize int64) ([]byte, error)
+}
+
+// String returns the artifact in the form pipeline/counter/stage/counter/job/path.
+func (locator ArtifactLocator) String() string {
+	return path.Join(locator.Pipeline, strconv.Itoa(locator.PipelineCounter), locator.Stage, locator.StageCounter, locator.Job, locator.Path)
+}
+
+// GetArtifactRaw downloads the artifact file, the SDK does not support fetching the artifacts.
+// It errors without reading the rest of the file when the file is larger than maxSize bytes.
+func (client *GoCDClient) GetArtifactRaw(locator ArtifactLocator, maxSize int64) ([]byte, error) {
+	if err := client.RequireServerVersion("artifacts", MinArtifactVersion); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.templateClient.R().
+		SetDoNotParseResponse(true).
+		Get(path.Join(artifactFilesEndpoint, locator.String()))
+	if err != nil {
+		return nil, fmt.Errorf("get artifact '%s': %w", locator, err)
+	}
+
+	body := resp.RawBody()
+	defer body.Close()
+
+	if resp.StatusCode() != http.StatusOK {
+		// the body is not read by resty when the response is not parsed, it is read here so that the error carries the reason.
+		errorBody, _ := io.ReadAll(io.LimitReader(body, maxArtifactErrorSize))
+
+		return nil, &goErr.NonOkError{Code: resp.StatusCode(), Response: resp.SetBody(errorBody)}
+	}
+
+	if resp.RawResponse.ContentLength > maxSize {
+		return nil, fmt.Errorf("artifact '%s' of %d bytes is larger than the maximum allowed size of %d bytes", locator, resp.RawResponse.ContentLength, maxSize)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read artifact '%s': %w", locator, err)
+	}
+
+	if int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("artifact '%s' is larger than the maximum allowed size of %d bytes", locator, maxSize)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,63 @@
+//nolint:testpackage
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+func TestGetArtifactRaw(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/files/helm-images/4/build/1/package/dist/version.txt":
+			_, _ = writer.Write([]byte("1.2.3\n"))
+		case "/files/helm-images/4/build/1/package/dist/chart.tgz":
+			// streamed without the content length, so that the size is known only while reading it.
+			writer.Header().Set("Content-Type", "application/octet-stream")
+			writer.(http.Flusher).Flush()
+			_, _ = writer.Write([]byte(strings.Repeat("a", 64)))
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+			_, _ = writer.Write([]byte("Artifact '" + request.URL.Path + "' is unavailable as it may have been purged by Go or deleted externally."))
+		}
+	}))
+	defer server.Close()
+
+	client := newGoCDClient(server.URL, gocd.Auth{NoAuth: true}, "info", nil)
+
+	locator := ArtifactLocator{Pipeline: "helm-images", PipelineCounter: 4, Stage: "build", StageCounter: "1", Job: "package"}
+
+	t.Run("artifact within the size", func(t *testing.T) {
+		locator.Path = "dist/version.txt"
+
+		content, err := client.GetArtifactRaw(locator, 16)
+		if err != nil || string(content) != "1.2.3\n" {
+			t.Errorf("unexpected artifact content %q with error: %v", content, err)
+		}
+	})
+
+	t.Run("artifact larger than the size", func(t *testing.T) {
+		locator.Path = "dist/chart.tgz"
+
+		if _, err := client.GetArtifactRaw(locator, 16); err == nil {
+			t.Errorf("expected an error for the artifact larger than the maximum size")
+		}
+	})
+
+	t.Run("missing artifact", func(t *testing.T) {
+		locator.Path = "dist/missing.txt"
+
+		_, err := client.GetArtifactRaw(locator, 16)
+		if !IsNotFound(err) {
+			t.Fatalf("expected not found error, got: %v", err)
+		}
+
+		if !strings.Contains(err.Error(), "may have been purged") {
+			t.Errorf("expected the error to carry the reason reported by GoCD, got: %v", err)
+		}
+	})
+}
@@ -93,6 +93,8 @@ func GetGoCDClient(_ context.Context, d *schema.ResourceData) (any, diag.Diagnos
 				return nil, diag.Errorf("errored while connecting to server\nerror: %v\nkindly re-check the baseURL and authorization config before rerunning plan again", err)
 			}
 		}
+
+		goCDClient.recordServerVersion()
 	}
 
 	return goCDClient, nil
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+// MinConfigRepoPreflightVersion is the oldest GoCD version supporting the preflight check of the config repos.
+const MinConfigRepoPreflightVersion = "19.2.0"
+
+// PreflightFile holds a config repo definition file to be checked, by its name and content.
+type PreflightFile struct {
+	Name    string
+	Content string
+}
+
+// PreflightResult holds the outcome of the config repo preflight check.
+type PreflightResult struct {
+	Valid  bool     `json:"valid"`
+	Errors []string `json:"errors"`
+}
+
+type ConfigRepoPreflightClient interface {
+	ConfigRepoPreflightRaw(files []PreflightFile, pluginID, repoID string) (PreflightResult, error)
+}
+
+// ConfigRepoPreflightRaw runs the preflight check on the definition files passed by their content, unlike
+// gocd.ConfigRepoPreflightCheckFiles it does not read the files from the disk and returns the errors reported as is.
+func (client *GoCDClient) ConfigRepoPreflightRaw(files []PreflightFile, pluginID, repoID string) (PreflightResult, error) {
+	var result PreflightResult
+
+	if err := client.RequireServerVersion("config repo preflight", MinConfigRepoPreflightVersion); err != nil {
+		return result, err
+	}
+
+	request := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept": gocd.HeaderVersionOne,
+		}).
+		SetQueryParam("pluginId", pluginID)
+
+	if len(repoID) != 0 {
+		request.SetQueryParam("repoId", repoID)
+	}
+
+	for _, file := range files {
+		request.SetFileReader("files[]", file.Name, strings.NewReader(file.Content))
+	}
+
+	resp, err := request.Post(gocd.PreflightCheckEndpoint)
+	if err != nil {
+		return result, fmt.Errorf("preflight check with plugin '%s': %w", pluginID, err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return result, &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
+	}
+
+	if err = json.Unmarshal(resp.Body(), &result); err != nil {
+		return result, fmt.Errorf("decode preflight check response: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,68 @@
+//nolint:testpackage
+package client
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+func TestConfigRepoPreflightRaw(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodPost || request.URL.Path != "/api/admin/config_repo_ops/preflight" {
+			writer.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if request.URL.Query().Get("pluginId") != "yaml.config.plugin" || request.URL.Query().Has("repoId") {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if err := request.ParseMultipartForm(1 << 20); err != nil {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		files := request.MultipartForm.File["files[]"]
+		if len(files) != 1 || files[0].Filename != "build.gocd.yaml" {
+			writer.WriteHeader(http.StatusUnprocessableEntity)
+
+			return
+		}
+
+		file, err := files[0].Open()
+		if err != nil {
+			writer.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+		defer file.Close()
+
+		if content, _ := io.ReadAll(file); string(content) != "format_version: 10\n" {
+			writer.WriteHeader(http.StatusUnprocessableEntity)
+
+			return
+		}
+
+		_, _ = writer.Write([]byte(`{"errors":["build.gocd.yaml: pipelines are missing"],"valid":false}`))
+	}))
+	defer server.Close()
+
+	client := newGoCDClient(server.URL, gocd.Auth{NoAuth: true}, "info", nil)
+
+	result, err := client.ConfigRepoPreflightRaw([]PreflightFile{{Name: "build.gocd.yaml", Content: "format_version: 10\n"}}, "yaml.config.plugin", "")
+	if err != nil {
+		t.Fatalf("preflight check errored with: %v", err)
+	}
+
+	if result.Valid || len(result.Errors) != 1 || result.Errors[0] != "build.gocd.yaml: pipelines are missing" {
+		t.Errorf("unexpected preflight result: %+v", result)
+	}
+}
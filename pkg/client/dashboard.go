@@ -0,0 +1,75 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+const (
+	dashboardEndpoint = "/api/dashboard"
+	// MinDashboardVersion is the oldest GoCD version serving the dashboard with the version 4 of the API.
+	MinDashboardVersion = "20.1.0"
+)
+
+// DashboardPipeline holds the state of a pipeline as listed on the GoCD dashboard.
+type DashboardPipeline struct {
+	Name           string
+	Paused         bool
+	FromConfigRepo bool
+}
+
+type DashboardClient interface {
+	GetDashboardPipelinesRaw() (map[string]DashboardPipeline, error)
+}
+
+// GetDashboardPipelinesRaw fetches the state of all the pipelines in a single call, keyed by the pipeline name.
+// Unlike gocd.GetPipelineState which has to be called for every pipeline.
+func (client *GoCDClient) GetDashboardPipelinesRaw() (map[string]DashboardPipeline, error) {
+	if err := client.RequireServerVersion("dashboard", MinDashboardVersion); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept": gocd.HeaderVersionFour,
+		}).
+		Get(dashboardEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("get dashboard: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
+	}
+
+	var response struct {
+		Embedded struct {
+			Pipelines []struct {
+				Name      string `json:"name"`
+				PauseInfo struct {
+					Paused bool `json:"paused"`
+				} `json:"pause_info"`
+				FromConfigRepo bool `json:"from_config_repo"`
+			} `json:"pipelines"`
+		} `json:"_embedded"`
+	}
+
+	if err = json.Unmarshal(resp.Body(), &response); err != nil {
+		return nil, fmt.Errorf("decode dashboard response: %w", err)
+	}
+
+	pipelines := make(map[string]DashboardPipeline, len(response.Embedded.Pipelines))
+	for _, pipeline := range response.Embedded.Pipelines {
+		pipelines[pipeline.Name] = DashboardPipeline{
+			Name:           pipeline.Name,
+			Paused:         pipeline.PauseInfo.Paused,
+			FromConfigRepo: pipeline.FromConfigRepo,
+		}
+	}
+
+	return pipelines, nil
+}
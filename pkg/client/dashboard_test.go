@@ -0,0 +1,59 @@
+//nolint:testpackage
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+func TestGetDashboardPipelinesRaw(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != "/api/dashboard" || request.Header.Get("Accept") != gocd.HeaderVersionFour {
+			writer.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = writer.Write([]byte(`{
+  "_personalization": "6b8f3b2d",
+  "_embedded": {
+    "pipeline_groups": [{"name": "helm", "pipelines": ["helm-images", "helm-drift"], "can_administer": true}],
+    "pipelines": [
+      {
+        "name": "helm-images",
+        "locked": false,
+        "pause_info": {"paused": true, "paused_by": "admin", "pause_reason": "upgrading agents"},
+        "from_config_repo": false,
+        "_embedded": {"instances": []}
+      },
+      {
+        "name": "helm-drift",
+        "locked": false,
+        "pause_info": {"paused": false, "paused_by": null, "pause_reason": null},
+        "from_config_repo": true,
+        "_embedded": {"instances": []}
+      }
+    ]
+  }
+}`))
+	}))
+	defer server.Close()
+
+	client := newGoCDClient(server.URL, gocd.Auth{NoAuth: true}, "info", nil)
+
+	pipelines, err := client.GetDashboardPipelinesRaw()
+	if err != nil {
+		t.Fatalf("getting dashboard errored with: %v", err)
+	}
+
+	if pipeline := pipelines["helm-images"]; !pipeline.Paused || pipeline.FromConfigRepo {
+		t.Errorf("unexpected state of helm-images: %+v", pipeline)
+	}
+
+	if pipeline := pipelines["helm-drift"]; pipeline.Paused || !pipeline.FromConfigRepo {
+		t.Errorf("unexpected state of helm-drift: %+v", pipeline)
+	}
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+// IsNotFound reports whether the error returned while calling GoCD was due to the object not being present,
+// so that the callers can differentiate an object deleted outside terraform from other failures.
+func IsNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var nonFoundErr *goErr.NonFoundError
+	if errors.As(err, &nonFoundErr) {
+		return true
+	}
+
+	var nonOkErr *goErr.NonOkError
+	if errors.As(err, &nonOkErr) {
+		return nonOkErr.Code == http.StatusNotFound
+	}
+
+	var nonOkValueErr goErr.NonOkError
+	if errors.As(err, &nonOkValueErr) {
+		return nonOkValueErr.Code == http.StatusNotFound
+	}
+
+	return false
+}
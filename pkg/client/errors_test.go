@@ -0,0 +1,34 @@
+//nolint:testpackage
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+func TestIsNotFound(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		notFound bool
+	}{
+		"nil error":            {err: nil, notFound: false},
+		"non ok error 404":     {err: &goErr.NonOkError{Code: http.StatusNotFound}, notFound: true},
+		"wrapped non ok 404":   {err: fmt.Errorf("get pipeline: %w", &goErr.NonOkError{Code: http.StatusNotFound}), notFound: true},
+		"non ok error 500":     {err: &goErr.NonOkError{Code: http.StatusInternalServerError}, notFound: false},
+		"not found error":      {err: &goErr.NonFoundError{}, notFound: true},
+		"api error":            {err: &goErr.APIError{Err: errors.New("connection refused")}, notFound: false},
+		"unclassified failure": {err: errors.New("not found"), notFound: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.notFound {
+				t.Fatalf("expected IsNotFound to be %t, got %t", tt.notFound, got)
+			}
+		})
+	}
+}
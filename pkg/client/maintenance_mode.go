@@ -0,0 +1,92 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+// MinMaintenanceModeVersion is the oldest GoCD version supporting the maintenance mode.
+const MinMaintenanceModeVersion = "19.1.0"
+
+// MaintenanceMode holds information of the maintenance mode of GoCD along with the systems still running in it.
+type MaintenanceMode struct {
+	Enabled                   bool
+	UpdatedBy                 string
+	UpdatedOn                 string
+	HasRunningSystems         bool
+	MaterialUpdatesInProgress int
+	BuildingJobs              int
+	ScheduledJobs             int
+}
+
+type maintenanceModeInfo struct {
+	Enabled  bool `json:"is_maintenance_mode"`
+	Metadata struct {
+		UpdatedBy string `json:"updated_by"`
+		UpdatedOn string `json:"updated_on"`
+	} `json:"metadata"`
+	Attributes struct {
+		HasRunningSystems bool `json:"has_running_systems"`
+		RunningSystems    struct {
+			MaterialUpdateInProgress []json.RawMessage `json:"material_update_in_progress"`
+			BuildingJobs             []json.RawMessage `json:"building_jobs"`
+			ScheduledJobs            []json.RawMessage `json:"scheduled_jobs"`
+		} `json:"running_systems"`
+	} `json:"attributes"`
+}
+
+type MaintenanceModeClient interface {
+	GetMaintenanceModeRaw() (MaintenanceMode, error)
+}
+
+// GetMaintenanceModeRaw fetches the maintenance mode information, unlike gocd.GetMaintenanceModeInfo
+// it includes the systems that are still running, which is required to know whether the builds are drained.
+func (client *GoCDClient) GetMaintenanceModeRaw() (MaintenanceMode, error) {
+	if err := client.RequireServerVersion("maintenance mode", MinMaintenanceModeVersion); err != nil {
+		return MaintenanceMode{}, err
+	}
+
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept": gocd.HeaderVersionOne,
+		}).
+		Get(filepath.Join(gocd.MaintenanceEndpoint, "info"))
+	if err != nil {
+		return MaintenanceMode{}, fmt.Errorf("get maintenance mode information: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return MaintenanceMode{}, &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
+	}
+
+	// older versions of GoCD nest the information under _embedded.
+	var response struct {
+		maintenanceModeInfo
+
+		Embedded *maintenanceModeInfo `json:"_embedded"`
+	}
+
+	if err = json.Unmarshal(resp.Body(), &response); err != nil {
+		return MaintenanceMode{}, fmt.Errorf("decode maintenance mode response: %w", err)
+	}
+
+	info := response.maintenanceModeInfo
+	if response.Embedded != nil {
+		info = *response.Embedded
+	}
+
+	return MaintenanceMode{
+		Enabled:                   info.Enabled,
+		UpdatedBy:                 info.Metadata.UpdatedBy,
+		UpdatedOn:                 info.Metadata.UpdatedOn,
+		HasRunningSystems:         info.Attributes.HasRunningSystems,
+		MaterialUpdatesInProgress: len(info.Attributes.RunningSystems.MaterialUpdateInProgress),
+		BuildingJobs:              len(info.Attributes.RunningSystems.BuildingJobs),
+		ScheduledJobs:             len(info.Attributes.RunningSystems.ScheduledJobs),
+	}, nil
+}
@@ -0,0 +1,56 @@
+//nolint:testpackage
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+func TestGetMaintenanceModeRaw(t *testing.T) {
+	responses := map[string]string{
+		"top level": `{"is_maintenance_mode":true,"metadata":{"updated_by":"admin","updated_on":"2023-01-01T10:00:00Z"},` +
+			`"attributes":{"has_running_systems":true,"running_systems":{"material_update_in_progress":[],` +
+			`"building_jobs":[{"pipeline_name":"helm-images"}],"scheduled_jobs":[{},{}]}}}`,
+		"embedded": `{"_embedded":{"is_maintenance_mode":true,"metadata":{"updated_by":"admin","updated_on":"2023-01-01T10:00:00Z"},` +
+			`"attributes":{"has_running_systems":true,"running_systems":{"material_update_in_progress":[],` +
+			`"building_jobs":[{"pipeline_name":"helm-images"}],"scheduled_jobs":[{},{}]}}}}`,
+	}
+
+	for name, body := range responses {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				if request.Method != http.MethodGet || request.URL.Path != "/api/admin/maintenance_mode/info" {
+					writer.WriteHeader(http.StatusNotFound)
+
+					return
+				}
+
+				_, _ = writer.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			client := newGoCDClient(server.URL, gocd.Auth{NoAuth: true}, "info", nil)
+
+			info, err := client.GetMaintenanceModeRaw()
+			if err != nil {
+				t.Fatalf("getting maintenance mode errored with: %v", err)
+			}
+
+			expected := MaintenanceMode{
+				Enabled:           true,
+				UpdatedBy:         "admin",
+				UpdatedOn:         "2023-01-01T10:00:00Z",
+				HasRunningSystems: true,
+				BuildingJobs:      1,
+				ScheduledJobs:     2,
+			}
+
+			if info != expected {
+				t.Errorf("expected %+v, got %+v", expected, info)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+type PackageClient interface {
+	CreatePackageRaw(attributes map[string]any) (gocd.Package, error)
+	UpdatePackageRaw(id, etag string, attributes map[string]any) (gocd.Package, error)
+}
+
+// CreatePackageRaw creates the package with the attributes passed, unlike gocd.CreatePackage auto_update set to false
+// is sent to GoCD as is, instead of being defaulted to true.
+func (client *GoCDClient) CreatePackageRaw(attributes map[string]any) (gocd.Package, error) {
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept":       gocd.HeaderVersionTwo,
+			"Content-Type": gocd.ContentJSON,
+		}).
+		SetBody(attributes).
+		Post(gocd.PackagesEndpoint)
+	if err != nil {
+		return gocd.Package{}, fmt.Errorf("create package '%v': %w", attributes["id"], err)
+	}
+
+	return decodePackageResponse(resp)
+}
+
+// UpdatePackageRaw updates the package identified by id with the attributes passed, unlike gocd.UpdatePackage
+// auto_update set to false is sent to GoCD as is, instead of being defaulted to true.
+func (client *GoCDClient) UpdatePackageRaw(id, etag string, attributes map[string]any) (gocd.Package, error) {
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept":       gocd.HeaderVersionTwo,
+			"Content-Type": gocd.ContentJSON,
+			"If-Match":     etag,
+		}).
+		SetBody(attributes).
+		Put(filepath.Join(gocd.PackagesEndpoint, id))
+	if err != nil {
+		return gocd.Package{}, fmt.Errorf("update package '%s': %w", id, err)
+	}
+
+	return decodePackageResponse(resp)
+}
+
+func decodePackageResponse(resp *resty.Response) (gocd.Package, error) {
+	var packageCfg gocd.Package
+
+	if resp.StatusCode() != http.StatusOK {
+		return packageCfg, &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
+	}
+
+	if err := json.Unmarshal(resp.Body(), &packageCfg); err != nil {
+		return packageCfg, fmt.Errorf("decode package response: %w", err)
+	}
+
+	packageCfg.ETAG = resp.Header().Get("ETag")
+
+	return packageCfg, nil
+}
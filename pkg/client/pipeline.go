@@ -0,0 +1,49 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+type PipelineConfigClient interface {
+	GetPipelineConfigRaw(name string) (gocd.PipelineConfig, error)
+}
+
+// GetPipelineConfigRaw fetches the pipeline config as returned by GoCD, the complete response is preserved under Config
+// so that fields unknown to gocd.PipelineConfig are not lost while comparing it with the config managed by terraform.
+func (client *GoCDClient) GetPipelineConfigRaw(name string) (gocd.PipelineConfig, error) {
+	var pipelineCfg gocd.PipelineConfig
+
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept": gocd.HeaderVersionEleven,
+		}).
+		Get(filepath.Join(gocd.PipelineConfigEndpoint, name))
+	if err != nil {
+		return pipelineCfg, fmt.Errorf("get pipeline config '%s': %w", name, err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return pipelineCfg, &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
+	}
+
+	var rawConfig map[string]any
+	if err = json.Unmarshal(resp.Body(), &rawConfig); err != nil {
+		return pipelineCfg, fmt.Errorf("decode pipeline config response: %w", err)
+	}
+
+	pipelineCfg.Name = name
+	pipelineCfg.Config = rawConfig
+	pipelineCfg.ETAG = resp.Header().Get("ETag")
+
+	if group, ok := rawConfig["group"].(string); ok {
+		pipelineCfg.Group = group
+	}
+
+	return pipelineCfg, nil
+}
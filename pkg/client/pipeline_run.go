@@ -0,0 +1,200 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+// GoCD accepts the page size of the pipeline history only within this range, and errors with 422 otherwise.
+const (
+	MinPipelineHistoryPageSize = 10
+	MaxPipelineHistoryPageSize = 100
+)
+
+// MinPipelineHistoryVersion is the oldest GoCD version paginating the pipeline history with the cursors.
+const MinPipelineHistoryVersion = "20.1.0"
+
+// PipelineInstance holds information of a specific run of the pipeline, as returned by the pipeline instance and history APIs.
+type PipelineInstance struct {
+	Name                string          `json:"name,omitempty" yaml:"name,omitempty"`
+	Counter             int             `json:"counter,omitempty" yaml:"counter,omitempty"`
+	Label               string          `json:"label,omitempty" yaml:"label,omitempty"`
+	NaturalOrder        float64         `json:"natural_order,omitempty" yaml:"natural_order,omitempty"`
+	CanRun              bool            `json:"can_run,omitempty" yaml:"can_run,omitempty"`
+	PreparingToSchedule bool            `json:"preparing_to_schedule,omitempty" yaml:"preparing_to_schedule,omitempty"`
+	Comment             string          `json:"comment,omitempty" yaml:"comment,omitempty"`
+	ScheduledDate       int64           `json:"scheduled_date,omitempty" yaml:"scheduled_date,omitempty"`
+	BuildCause          BuildCause      `json:"build_cause,omitempty" yaml:"build_cause,omitempty"`
+	Stages              []StageInstance `json:"stages,omitempty" yaml:"stages,omitempty"`
+}
+
+// BuildCause holds information of what triggered the pipeline instance.
+type BuildCause struct {
+	TriggerMessage    string             `json:"trigger_message,omitempty" yaml:"trigger_message,omitempty"`
+	TriggerForced     bool               `json:"trigger_forced,omitempty" yaml:"trigger_forced,omitempty"`
+	Approver          string             `json:"approver,omitempty" yaml:"approver,omitempty"`
+	MaterialRevisions []MaterialRevision `json:"material_revisions,omitempty" yaml:"material_revisions,omitempty"`
+}
+
+// MaterialRevision holds information of the material revisions used by the pipeline instance.
+type MaterialRevision struct {
+	Changed  bool `json:"changed,omitempty" yaml:"changed,omitempty"`
+	Material struct {
+		Name        string `json:"name,omitempty" yaml:"name,omitempty"`
+		Fingerprint string `json:"fingerprint,omitempty" yaml:"fingerprint,omitempty"`
+		Type        string `json:"type,omitempty" yaml:"type,omitempty"`
+		Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	} `json:"material,omitempty" yaml:"material,omitempty"`
+	Modifications []struct {
+		Revision     string `json:"revision,omitempty" yaml:"revision,omitempty"`
+		UserName     string `json:"user_name,omitempty" yaml:"user_name,omitempty"`
+		Comment      string `json:"comment,omitempty" yaml:"comment,omitempty"`
+		ModifiedTime int64  `json:"modified_time,omitempty" yaml:"modified_time,omitempty"`
+	} `json:"modifications,omitempty" yaml:"modifications,omitempty"`
+}
+
+// StageInstance holds information of a specific run of the stage in the pipeline instance.
+type StageInstance struct {
+	Name              string        `json:"name,omitempty" yaml:"name,omitempty"`
+	Counter           json.Number   `json:"counter,omitempty" yaml:"counter,omitempty"`
+	Status            string        `json:"status,omitempty" yaml:"status,omitempty"`
+	Result            string        `json:"result,omitempty" yaml:"result,omitempty"`
+	ApprovalType      string        `json:"approval_type,omitempty" yaml:"approval_type,omitempty"`
+	ApprovedBy        string        `json:"approved_by,omitempty" yaml:"approved_by,omitempty"`
+	Scheduled         bool          `json:"scheduled,omitempty" yaml:"scheduled,omitempty"`
+	OperatePermission bool          `json:"operate_permission,omitempty" yaml:"operate_permission,omitempty"`
+	Jobs              []JobInstance `json:"jobs,omitempty" yaml:"jobs,omitempty"`
+}
+
+// JobInstance holds information of a specific run of the job in the stage instance.
+type JobInstance struct {
+	ID            int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Name          string `json:"name,omitempty" yaml:"name,omitempty"`
+	State         string `json:"state,omitempty" yaml:"state,omitempty"`
+	Result        string `json:"result,omitempty" yaml:"result,omitempty"`
+	ScheduledDate int64  `json:"scheduled_date,omitempty" yaml:"scheduled_date,omitempty"`
+}
+
+// PipelineInstances holds a page of the pipeline history.
+type PipelineInstances struct {
+	Links     map[string]any     `json:"_links,omitempty" yaml:"_links,omitempty"`
+	Pipelines []PipelineInstance `json:"pipelines,omitempty" yaml:"pipelines,omitempty"`
+}
+
+type PipelineRunClient interface {
+	SchedulePipelineRaw(name string, schedule map[string]any) error
+	GetPipelineInstanceRaw(name string, counter int) (PipelineInstance, error)
+	GetPipelineHistoryRaw(name string, pageSize int, after string) (PipelineInstances, error)
+}
+
+// SchedulePipelineRaw triggers the pipeline with the schedule passed, unlike gocd.SchedulePipeline
+// update_materials_before_scheduling set to false is sent to GoCD as is, instead of being defaulted to true.
+func (client *GoCDClient) SchedulePipelineRaw(name string, schedule map[string]any) error {
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept":       gocd.HeaderVersionOne,
+			"Content-Type": gocd.ContentJSON,
+		}).
+		SetBody(schedule).
+		Post(filepath.Join(gocd.PipelinesEndpoint, name, "schedule"))
+	if err != nil {
+		return fmt.Errorf("schedule pipeline '%s': %w", name, err)
+	}
+
+	if resp.StatusCode() != http.StatusAccepted {
+		return &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
+	}
+
+	return nil
+}
+
+// GetPipelineInstanceRaw fetches the specific run of the pipeline identified by its counter.
+func (client *GoCDClient) GetPipelineInstanceRaw(name string, counter int) (PipelineInstance, error) {
+	var instance PipelineInstance
+
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept": gocd.HeaderVersionOne,
+		}).
+		Get(filepath.Join(gocd.PipelinesEndpoint, name, strconv.Itoa(counter)))
+	if err != nil {
+		return instance, fmt.Errorf("get pipeline instance '%s/%d': %w", name, counter, err)
+	}
+
+	if err = decodePipelineRunResponse(resp, &instance); err != nil {
+		return instance, err
+	}
+
+	return instance, nil
+}
+
+// GetPipelineHistoryRaw fetches a single page of the pipeline history, latest runs first.
+// The after cursor of the next page can be obtained from PipelineInstances.NextCursor.
+func (client *GoCDClient) GetPipelineHistoryRaw(name string, pageSize int, after string) (PipelineInstances, error) {
+	var instances PipelineInstances
+
+	if err := client.RequireServerVersion("pipeline history", MinPipelineHistoryVersion); err != nil {
+		return instances, err
+	}
+
+	queryParams := map[string]string{"page_size": strconv.Itoa(pageSize)}
+	if len(after) != 0 {
+		queryParams["after"] = after
+	}
+
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept": gocd.HeaderVersionOne,
+		}).
+		SetQueryParams(queryParams).
+		Get(filepath.Join(gocd.PipelinesEndpoint, name, "history"))
+	if err != nil {
+		return instances, fmt.Errorf("get pipeline history '%s': %w", name, err)
+	}
+
+	if err = decodePipelineRunResponse(resp, &instances); err != nil {
+		return instances, err
+	}
+
+	return instances, nil
+}
+
+// NextCursor returns the cursor to be passed as after to fetch the next page of the pipeline history, empty when it is the last page.
+func (instances PipelineInstances) NextCursor() string {
+	next, ok := instances.Links["next"].(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	href, ok := next["href"].(string)
+	if !ok {
+		return ""
+	}
+
+	nextURL, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+
+	return nextURL.Query().Get("after")
+}
+
+func decodePipelineRunResponse(resp *resty.Response, out any) error {
+	if resp.StatusCode() != http.StatusOK {
+		return &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
+	}
+
+	if err := json.Unmarshal(resp.Body(), out); err != nil {
+		return fmt.Errorf("decode pipeline run response: %w", err)
+	}
+
+	return nil
+}
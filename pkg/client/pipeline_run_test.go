@@ -0,0 +1,50 @@
+//nolint:testpackage
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+func TestGetPipelineHistoryRaw(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != "/api/pipelines/sample/history" || request.URL.Query().Get("page_size") != "10" {
+			writer.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = writer.Write([]byte(`{
+  "_links": {"next": {"href": "https://gocd.example.com/go/api/pipelines/sample/history?page_size=10&after=41"}},
+  "pipelines": [{
+    "name": "sample",
+    "counter": 42,
+    "label": "42",
+    "stages": [{"name": "build", "counter": "1", "scheduled": true, "result": "Passed", "status": "Passed"}]
+  }]
+}`))
+	}))
+	defer server.Close()
+
+	client := newGoCDClient(server.URL, gocd.Auth{NoAuth: true}, "info", nil)
+
+	history, err := client.GetPipelineHistoryRaw("sample", 10, "")
+	if err != nil {
+		t.Fatalf("getting pipeline history errored with: %v", err)
+	}
+
+	if len(history.Pipelines) != 1 || history.Pipelines[0].Counter != 42 || history.Pipelines[0].Stages[0].Counter.String() != "1" {
+		t.Errorf("unexpected pipeline history: %+v", history.Pipelines)
+	}
+
+	if cursor := history.NextCursor(); cursor != "41" {
+		t.Errorf("expected next cursor '41', got '%s'", cursor)
+	}
+
+	if _, err = client.GetPipelineHistoryRaw("missing", 10, ""); !IsNotFound(err) {
+		t.Errorf("expected not found error, got: %v", err)
+	}
+}
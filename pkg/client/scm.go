@@ -0,0 +1,113 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+// SCMEndpoint is the endpoint to manage the pluggable SCMs, not supported by gocd-sdk-go.
+const SCMEndpoint = "/api/admin/scms"
+
+// SCM holds information of the pluggable SCM material in GoCD.
+type SCM struct {
+	ID             string                     `json:"id,omitempty" yaml:"id,omitempty"`
+	Name           string                     `json:"name,omitempty" yaml:"name,omitempty"`
+	AutoUpdate     bool                       `json:"auto_update" yaml:"auto_update"`
+	PluginMetadata map[string]string          `json:"plugin_metadata,omitempty" yaml:"plugin_metadata,omitempty"`
+	Configuration  []gocd.PluginConfiguration `json:"configuration,omitempty" yaml:"configuration,omitempty"`
+	ETAG           string                     `json:"etag,omitempty" yaml:"etag,omitempty"`
+}
+
+type SCMClient interface {
+	GetSCM(name string) (SCM, error)
+	CreateSCM(scm SCM) (SCM, error)
+	UpdateSCM(scm SCM) (SCM, error)
+	DeleteSCM(name string) error
+}
+
+// GetSCM fetches the pluggable SCM identified by its name.
+func (client *GoCDClient) GetSCM(name string) (SCM, error) {
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept": gocd.HeaderVersionFour,
+		}).
+		Get(filepath.Join(SCMEndpoint, name))
+	if err != nil {
+		return SCM{}, fmt.Errorf("get scm '%s': %w", name, err)
+	}
+
+	return decodeSCMResponse(resp)
+}
+
+// CreateSCM creates the pluggable SCM with the config passed, GoCD generates the ID when not set.
+func (client *GoCDClient) CreateSCM(scm SCM) (SCM, error) {
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept":       gocd.HeaderVersionFour,
+			"Content-Type": gocd.ContentJSON,
+		}).
+		SetBody(scm).
+		Post(SCMEndpoint)
+	if err != nil {
+		return SCM{}, fmt.Errorf("create scm '%s': %w", scm.Name, err)
+	}
+
+	return decodeSCMResponse(resp)
+}
+
+// UpdateSCM updates the pluggable SCM identified by its name, ETAG of the latest SCM config is required.
+func (client *GoCDClient) UpdateSCM(scm SCM) (SCM, error) {
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept":       gocd.HeaderVersionFour,
+			"Content-Type": gocd.ContentJSON,
+			"If-Match":     scm.ETAG,
+		}).
+		SetBody(scm).
+		Put(filepath.Join(SCMEndpoint, scm.Name))
+	if err != nil {
+		return SCM{}, fmt.Errorf("update scm '%s': %w", scm.Name, err)
+	}
+
+	return decodeSCMResponse(resp)
+}
+
+// DeleteSCM deletes the pluggable SCM identified by its name, GoCD rejects it when the SCM is used by any pipeline.
+func (client *GoCDClient) DeleteSCM(name string) error {
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept": gocd.HeaderVersionFour,
+		}).
+		Delete(filepath.Join(SCMEndpoint, name))
+	if err != nil {
+		return fmt.Errorf("delete scm '%s': %w", name, err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
+	}
+
+	return nil
+}
+
+func decodeSCMResponse(resp *resty.Response) (SCM, error) {
+	var scm SCM
+
+	if resp.StatusCode() != http.StatusOK {
+		return scm, &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
+	}
+
+	if err := json.Unmarshal(resp.Body(), &scm); err != nil {
+		return scm, fmt.Errorf("decode scm response: %w", err)
+	}
+
+	scm.ETAG = resp.Header().Get("ETag")
+
+	return scm, nil
+}
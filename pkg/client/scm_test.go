@@ -0,0 +1,64 @@
+//nolint:testpackage
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+func TestSCM(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch {
+		case request.Method == http.MethodPut && request.URL.Path == "/api/admin/scms/github-pr":
+			if request.Header.Get("If-Match") != "etag-1" {
+				writer.WriteHeader(http.StatusPreconditionFailed)
+
+				return
+			}
+
+			var body map[string]any
+			if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+				writer.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+
+			if autoUpdate, ok := body["auto_update"]; !ok || autoUpdate != false {
+				writer.WriteHeader(http.StatusUnprocessableEntity)
+
+				return
+			}
+
+			writer.Header().Set("ETag", "etag-2")
+			_, _ = writer.Write([]byte(`{"id":"scm-1","name":"github-pr","auto_update":false}`))
+		case request.Method == http.MethodGet && request.URL.Path == "/api/admin/scms/missing":
+			writer.WriteHeader(http.StatusNotFound)
+		default:
+			writer.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	client := newGoCDClient(server.URL, gocd.Auth{NoAuth: true}, "info", nil)
+
+	t.Run("update sends auto_update when false", func(t *testing.T) {
+		scm, err := client.UpdateSCM(SCM{Name: "github-pr", AutoUpdate: false, ETAG: "etag-1"})
+		if err != nil {
+			t.Fatalf("updating scm errored with: %v", err)
+		}
+
+		if scm.ID != "scm-1" || scm.ETAG != "etag-2" || scm.AutoUpdate {
+			t.Errorf("unexpected scm in response: %+v", scm)
+		}
+	})
+
+	t.Run("missing scm is not found", func(t *testing.T) {
+		if _, err := client.GetSCM("missing"); !IsNotFound(err) {
+			t.Errorf("expected not found error, got: %v", err)
+		}
+	})
+}
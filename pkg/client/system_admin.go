@@ -0,0 +1,76 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+type SystemAdminClient interface {
+	GetSystemAdminsRaw() (gocd.SystemAdmins, error)
+	UpdateSystemAdminsRaw(data gocd.SystemAdmins) (gocd.SystemAdmins, error)
+}
+
+// GetSystemAdminsRaw fetches the system admins along with the etag, which is not captured by gocd.GetSystemAdmins.
+func (client *GoCDClient) GetSystemAdminsRaw() (gocd.SystemAdmins, error) {
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept": gocd.HeaderVersionTwo,
+		}).
+		Get(gocd.SystemAdminEndpoint)
+	if err != nil {
+		return gocd.SystemAdmins{}, fmt.Errorf("get system admins: %w", err)
+	}
+
+	return decodeSystemAdminsResponse(resp)
+}
+
+// UpdateSystemAdminsRaw replaces the system admins with the users and roles passed, unlike gocd.UpdateSystemAdmins
+// the users and roles are sent to GoCD even when emptied, so that they can be unset.
+func (client *GoCDClient) UpdateSystemAdminsRaw(data gocd.SystemAdmins) (gocd.SystemAdmins, error) {
+	users, roles := data.Users, data.Roles
+	if users == nil {
+		users = make([]string, 0)
+	}
+
+	if roles == nil {
+		roles = make([]string, 0)
+	}
+
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept":       gocd.HeaderVersionTwo,
+			"Content-Type": gocd.ContentJSON,
+			"If-Match":     data.ETAG,
+		}).
+		SetBody(map[string][]string{
+			"users": users,
+			"roles": roles,
+		}).
+		Put(gocd.SystemAdminEndpoint)
+	if err != nil {
+		return gocd.SystemAdmins{}, fmt.Errorf("update system admins: %w", err)
+	}
+
+	return decodeSystemAdminsResponse(resp)
+}
+
+func decodeSystemAdminsResponse(resp *resty.Response) (gocd.SystemAdmins, error) {
+	var admins gocd.SystemAdmins
+
+	if resp.StatusCode() != http.StatusOK {
+		return admins, &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
+	}
+
+	if err := json.Unmarshal(resp.Body(), &admins); err != nil {
+		return admins, fmt.Errorf("decode system admins response: %w", err)
+	}
+
+	admins.ETAG = resp.Header().Get("ETag")
+
+	return admins, nil
+}
@@ -11,12 +11,14 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
 )
 
 type GoCDClient struct {
 	gocd.GoCd
 
 	templateClient *resty.Client
+	serverVersion  string
 }
 
 type PipelineTemplateClient interface {
@@ -101,8 +103,7 @@ func (client *GoCDClient) UpdateTemplateRaw(name, etag string, config map[string
 
 func decodeTemplateResponse(resp *resty.Response, template gocd.Template) (gocd.Template, error) {
 	if resp.StatusCode() != http.StatusOK {
-		return template, fmt.Errorf("got %d from GoCD while making %s call for %s\nwith BODY:%s",
-			resp.StatusCode(), resp.Request.Method, resp.Request.URL, resp.String())
+		return template, &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
 	}
 
 	if err := json.Unmarshal(resp.Body(), &template); err != nil {
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+type UserClient interface {
+	UpdateUserRaw(loginName string, attributes map[string]any) (gocd.User, error)
+}
+
+// UpdateUserRaw updates the user identified by its login name, unlike gocd.UpdateUser attributes set to false
+// or emptied are sent to GoCD as is, so that they can be unset.
+func (client *GoCDClient) UpdateUserRaw(loginName string, attributes map[string]any) (gocd.User, error) {
+	var user gocd.User
+
+	resp, err := client.templateClient.R().
+		SetHeaders(map[string]string{
+			"Accept":       gocd.HeaderVersionThree,
+			"Content-Type": gocd.ContentJSON,
+		}).
+		SetBody(attributes).
+		Patch(filepath.Join(gocd.UsersEndpoint, loginName))
+	if err != nil {
+		return user, fmt.Errorf("update user '%s': %w", loginName, err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return user, &goErr.NonOkError{Code: resp.StatusCode(), Response: resp}
+	}
+
+	if err = json.Unmarshal(resp.Body(), &user); err != nil {
+		return user, fmt.Errorf("decode user response: %w", err)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// Oldest GoCD versions supporting the features served by gocd-sdk-go,
+// the ones of the features served by this client are declared along with their methods.
+const (
+	MinClusterProfileVersion = "19.3.0"
+	MinSecretConfigVersion   = "19.6.0"
+)
+
+type ServerVersionClient interface {
+	ServerVersion() string
+	RequireServerVersion(feature, minimum string) error
+}
+
+// ServerVersion returns the version of the GoCD server recorded while configuring the provider,
+// it would be empty when the version could not be fetched or the server check is skipped.
+func (client *GoCDClient) ServerVersion() string {
+	return client.serverVersion
+}
+
+// RequireServerVersion errors when the GoCD server is older than the minimum version required by the feature.
+// The check passes when the server version is unknown, leaving it to GoCD to reject the call.
+func (client *GoCDClient) RequireServerVersion(feature, minimum string) error {
+	if len(client.serverVersion) == 0 {
+		return nil
+	}
+
+	if compareVersions(client.serverVersion, minimum) < 0 {
+		return fmt.Errorf("%s requires GoCD server version %s or newer, but the server is running %s", feature, minimum, client.serverVersion)
+	}
+
+	return nil
+}
+
+func (client *GoCDClient) recordServerVersion() {
+	versionInfo, err := client.GetVersionInfo()
+	if err != nil {
+		log.Printf("fetching GoCD server version errored with: %v, features would not be checked against the server version", err)
+
+		return
+	}
+
+	log.Printf("GoCD server version is %s", versionInfo.Version)
+
+	client.serverVersion = versionInfo.Version
+}
+
+// compareVersions compares the dot separated GoCD versions ex: 23.1.0, returns -1, 0 or 1 when a is older than, same as or newer than b.
+func compareVersions(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+
+	for index := 0; index < len(aParts) || index < len(bParts); index++ {
+		aPart, bPart := versionPart(aParts, index), versionPart(bParts, index)
+
+		switch {
+		case aPart < bPart:
+			return -1
+		case aPart > bPart:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func versionPart(parts []string, index int) int {
+	if index >= len(parts) {
+		return 0
+	}
+
+	// ignores the build number or any other suffix that follows the version, ex: 23.1.0-16079.
+	part, _, _ := strings.Cut(parts[index], "-")
+
+	value, err := strconv.Atoi(part)
+	if err != nil {
+		return 0
+	}
+
+	return value
+}
@@ -0,0 +1,105 @@
+//nolint:testpackage
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+func TestRequireServerVersion(t *testing.T) {
+	tests := map[string]struct {
+		serverVersion string
+		minimum       string
+		wantErr       bool
+	}{
+		"unknown server version": {
+			minimum: "19.6.0",
+		},
+		"same version": {
+			serverVersion: "19.6.0",
+			minimum:       "19.6.0",
+		},
+		"newer major version": {
+			serverVersion: "23.1.0",
+			minimum:       "19.6.0",
+		},
+		"newer patch version with build number": {
+			serverVersion: "19.6.1-9515",
+			minimum:       "19.6.0",
+		},
+		"older minor version": {
+			serverVersion: "19.3.0",
+			minimum:       "19.6.0",
+			wantErr:       true,
+		},
+		"older version with fewer parts": {
+			serverVersion: "19.5",
+			minimum:       "19.5.1",
+			wantErr:       true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &GoCDClient{serverVersion: test.serverVersion}
+
+			err := client.RequireServerVersion("secret configs", test.minimum)
+			if (err != nil) != test.wantErr {
+				t.Errorf("expected error: %v, got: %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRawClientsRequireServerVersion(t *testing.T) {
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		writer.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := newGoCDClient(server.URL, gocd.Auth{NoAuth: true}, "info", nil)
+	client.serverVersion = "19.1.0"
+
+	calls := map[string]func() error{
+		"pipeline history": func() error {
+			_, err := client.GetPipelineHistoryRaw("helm-images", MinPipelineHistoryPageSize, "")
+
+			return err
+		},
+		"dashboard": func() error {
+			_, err := client.GetDashboardPipelinesRaw()
+
+			return err
+		},
+		"config repo preflight": func() error {
+			_, err := client.ConfigRepoPreflightRaw([]PreflightFile{{Name: "build.gocd.yaml"}}, "yaml.config.plugin", "")
+
+			return err
+		},
+		"access tokens": func() error {
+			_, err := client.CreateAccessToken("terraform")
+
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			if err := call(); err == nil || !strings.Contains(err.Error(), "requires GoCD server version") {
+				t.Errorf("expected the server version to be rejected, got: %v", err)
+			}
+		})
+	}
+
+	if requests != 0 {
+		t.Errorf("expected no requests to GoCD older than the minimum version, got %d", requests)
+	}
+}
@@ -89,4 +89,119 @@ const (
 	TerraformResourceExtensions          = "extensions"
 	TerraformResourceSystemAdmin         = "system_admin"
 	TerraformResourceIsAdmin             = "is_admin"
+	TerraformResourceMaterials           = "materials"
+	TerraformResourceStages              = "stages"
+	TerraformResourceJobs                = "jobs"
+	TerraformResourceTasks               = "tasks"
+	TerraformResourceParameters          = "parameters"
+	TerraformResourceTimer               = "timer"
+	TerraformResourceTrackingTool        = "tracking_tool"
+	TerraformResourceLabelTemplate       = "label_template"
+	TerraformResourceLockBehavior        = "lock_behavior"
+	TerraformResourceTemplate            = "template"
+	TerraformResourceDeleteOnDestroy     = "delete_on_destroy"
+	TerraformResourceDisableOnDestroy    = "disable_on_destroy"
+	TerraformResourceUUIDs               = "uuids"
+	TerraformResourceFilter              = "filter"
+	TerraformResourceMatchedAgents       = "matched_agents"
+	TerraformResourceLoginName           = "login_name"
+	TerraformResourceDisplayName         = "display_name"
+	TerraformResourceEmail               = "email"
+	TerraformResourceEmailMe             = "email_me"
+	TerraformResourceCheckinAliases      = "checkin_aliases"
+	TerraformResourceEnabled             = "enabled"
+	TerraformResourceRole                = "role"
+	TerraformResourceRevokeCause         = "revoke_cause"
+	TerraformResourceToken               = "token"
+	TerraformResourceCreatedAt           = "created_at"
+	TerraformResourceLastUsedAt          = "last_used_at"
+	TerraformResourceSiteURL             = "site_url"
+	TerraformResourceSecureSiteURL       = "secure_site_url"
+	TerraformResourceTLS                 = "tls"
+	TerraformResourceSenderEmail         = "sender_email"
+	TerraformResourceAdminEmail          = "admin_email"
+	TerraformResourceArtifactsDir        = "artifacts_dir"
+	TerraformResourcePurgeStartDiskSpace = "purge_start_disk_space"
+	TerraformResourcePurgeUptoDiskSpace  = "purge_upto_disk_space"
+	TerraformResourceTimeout             = "timeout"
+	TerraformResourceRepoID              = "repo_id"
+	TerraformResourcePackageID           = "package_id"
+	TerraformResourcePluginVersion       = "plugin_version"
+	TerraformResourcePackages            = "packages"
+	TerraformResourceSCMID               = "scm_id"
+	TerraformResourceUser                = "user"
+	TerraformResourceSecureEnvVar        = "secure_environment_variables"
+	TerraformResourceUpdateMaterials     = "update_materials_before_scheduling"
+	TerraformResourceTriggers            = "triggers"
+	TerraformResourceWaitForCompletion   = "wait_for_completion"
+	TerraformResourceCounter             = "counter"
+	TerraformResourceLabel               = "label"
+	TerraformResourceResult              = "result"
+	TerraformResourcePaused              = "paused"
+	TerraformResourcePausedBy            = "paused_by"
+	TerraformResourceLocked              = "locked"
+	TerraformResourceUnlock              = "unlock"
+	TerraformResourceSchedulable         = "schedulable"
+	TerraformResourceWaitForDrain        = "wait_for_drain"
+	TerraformResourceUpdatedBy           = "updated_by"
+	TerraformResourceUpdatedOn           = "updated_on"
+	TerraformResourceHasRunningSystems   = "has_running_systems"
+	TerraformResourceBuildingJobs        = "building_jobs"
+	TerraformResourceScheduledJobs       = "scheduled_jobs"
+	TerraformResourceMaterialUpdates     = "material_updates_in_progress"
+	TerraformResourceWaitForParse        = "wait_for_parse"
+	TerraformResourceLastParsedRevision  = "last_parsed_revision"
+	TerraformResourceLastParseSuccess    = "last_parse_success"
+	TerraformResourceParseError          = "parse_error"
+	TerraformResourceGroups              = "groups"
+	TerraformResourceFiles               = "files"
+	TerraformResourceFile                = "file"
+	TerraformResourceContent             = "content"
+	TerraformResourceValid               = "valid"
+	TerraformResourceErrors              = "errors"
+	TerraformResourceMessage             = "message"
+	TerraformResourceAgents              = "agents"
+	TerraformResourceOrigin              = "origin"
+	TerraformResourceConfigRepoID        = "config_repo_id"
+	TerraformResourceFingerprints        = "material_fingerprints"
+	TerraformResourceExtensionType       = "extension_type"
+	TerraformResourcePluginIDs           = "plugin_ids"
+	TerraformResourcePlugins             = "plugins"
+	TerraformResourceVersion             = "version"
+	TerraformResourceFullVersion         = "full_version"
+	TerraformResourceGitSHA              = "git_sha"
+	TerraformResourceHealth              = "health"
+	TerraformResourceHealthMessages      = "health_messages"
+	TerraformResourceLevel               = "level"
+	TerraformResourceDetail              = "detail"
+	TerraformResourceTime                = "time"
+	TerraformResourceLatest              = "latest"
+	TerraformResourceLatestPassed        = "latest_passed"
+	TerraformResourceScheduledDate       = "scheduled_date"
+	TerraformResourceTriggeredBy         = "triggered_by"
+	TerraformResourceTriggerMessage      = "trigger_message"
+	TerraformResourceTriggerForced       = "trigger_forced"
+	TerraformResourceMaterialRevisions   = "material_revisions"
+	TerraformResourceChanged             = "changed"
+	TerraformResourceRevision            = "revision"
+	TerraformResourceModifiedBy          = "modified_by"
+	TerraformResourceComment             = "comment"
+	TerraformResourceState               = "state"
+	TerraformResourceApprovedBy          = "approved_by"
+	TerraformResourcePageSize            = "page_size"
+	TerraformResourceAfter               = "after"
+	TerraformResourceInstances           = "instances"
+	TerraformResourceNextCursor          = "next_cursor"
+	TerraformResourcePipelineCounter     = "pipeline_counter"
+	TerraformResourceStageCounter        = "stage_counter"
+	TerraformResourceJob                 = "job"
+	TerraformResourcePath                = "path"
+	TerraformResourceMaxSize             = "max_size"
+	TerraformResourceContentBase64       = "content_base64"
+	TerraformResourceSize                = "size"
+	TerraformResourceSHA256              = "sha256"
+	TerraformResourceStageStatus         = "status"
+	TerraformResourceNameRegex           = "name_regex"
+	TerraformResourceHostnameRegex       = "hostname_regex"
+	TerraformResourceConfigured          = "configured"
 )